@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/plugin/federation/gateway"
+)
+
+var composeCmd = &cli.Command{
+	Name:      "compose",
+	Usage:     "compose federated services into a supergraph",
+	ArgsUsage: "name=url...",
+	Flags: []cli.Flag{
+		&cli.StringFlag{Name: "output", Aliases: []string{"o"}, Usage: "write the supergraph schema to this file instead of stdout"},
+		&cli.StringFlag{Name: "query", Aliases: []string{"q"}, Usage: "execute a query against the supergraph and print its response"},
+		&cli.StringFlag{Name: "variables", Usage: "the variables of the query, as json"},
+	},
+	Action: func(ctx *cli.Context) error {
+		if ctx.NArg() == 0 {
+			return fmt.Errorf("at least one subgraph is needed, eg gqlgen compose accounts=http://localhost:4001/query")
+		}
+
+		var subgraphs []gateway.Subgraph
+		for _, arg := range ctx.Args().Slice() {
+			parts := strings.SplitN(arg, "=", 2)
+			if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+				return fmt.Errorf("subgraphs must be given as name=url, got %s", arg)
+			}
+			subgraphs = append(subgraphs, gateway.Subgraph{Name: parts[0], Handler: gateway.Remote(parts[1])})
+		}
+
+		gw, err := gateway.Compose(ctx.Context, subgraphs...)
+		if err != nil {
+			return err
+		}
+
+		query := ctx.String("query")
+		if query == "" {
+			if output := ctx.String("output"); output != "" {
+				return ioutil.WriteFile(output, []byte(gw.SDL()), 0o644)
+			}
+			_, err = fmt.Fprint(os.Stdout, gw.SDL())
+			return err
+		}
+
+		params := &graphql.RawParams{Query: query}
+		if variables := ctx.String("variables"); variables != "" {
+			dec := json.NewDecoder(strings.NewReader(variables))
+			dec.UseNumber()
+			if err := dec.Decode(&params.Variables); err != nil {
+				return fmt.Errorf("unable to decode variables: %w", err)
+			}
+		}
+
+		b, err := json.MarshalIndent(gw.Execute(ctx.Context, params), "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(os.Stdout, string(b))
+		return err
+	},
+}
@@ -35,6 +35,7 @@ func Execute() {
 	app.Commands = []*cli.Command{
 		genCmd,
 		initCmd,
+		composeCmd,
 		versionCmd,
 	}
 
@@ -8,6 +8,7 @@ import (
 	"regexp"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/99designs/gqlgen/internal/code"
 	"github.com/vektah/gqlparser/v2"
@@ -117,6 +118,8 @@ func CompleteConfig(config *Config) error {
 		"skip":       {SkipRuntime: true},
 		"include":    {SkipRuntime: true},
 		"deprecated": {SkipRuntime: true},
+		"defer":      {SkipRuntime: true},
+		"stream":     {SkipRuntime: true},
 	}
 
 	for key, value := range defaultDirectives {
@@ -243,6 +246,31 @@ func (c *Config) injectTypesFromSchema() error {
 		SkipRuntime: true,
 	}
 
+	// @cost and @listSize are read from the schema by complexity.CalculateCost, and @cacheControl by the CacheControl
+	// extension, unless the schema declares its own directives with the same names
+	for name, arg := range map[string]string{"cost": "weight", "listSize": "slicingArguments", "cacheControl": "maxAge"} {
+		def := c.Schema.Directives[name]
+		if _, defined := c.Directives[name]; !defined && def != nil && def.Arguments.ForName(arg) != nil {
+			c.Directives[name] = DirectiveConfig{SkipRuntime: true}
+		}
+	}
+
+	// @batch marks the resolver fields resolved for all the objects of a list at once, unless the config declares a
+	// directive with the same name
+	_, batchConfigured := c.Directives["batch"]
+	batchDirective := !batchConfigured && c.Schema.Directives["batch"] != nil
+	if batchDirective {
+		c.Directives["batch"] = DirectiveConfig{SkipRuntime: true}
+	}
+
+	// @timeout(ms:) sets the timeout of resolvers, unless the config declares a directive with the same name
+	if _, defined := c.Directives["timeout"]; !defined {
+		if def := c.Schema.Directives["timeout"]; def != nil && def.Arguments.ForName("ms") != nil {
+			c.Directives["timeout"] = DirectiveConfig{SkipRuntime: true}
+			c.injectTimeouts()
+		}
+	}
+
 	for _, schemaType := range c.Schema.Types {
 		if schemaType == c.Schema.Query || schemaType == c.Schema.Mutation || schemaType == c.Schema.Subscription {
 			continue
@@ -291,12 +319,115 @@ func (c *Config) injectTypesFromSchema() error {
 					c.Models[schemaType.Name].Fields[field.Name] = TypeMapField{
 						FieldName: fieldName,
 						Resolver:  forceResolver,
+						Batch:     c.Models[schemaType.Name].Fields[field.Name].Batch,
+						Timeout:   c.Models[schemaType.Name].Fields[field.Name].Timeout,
 					}
 				}
+
+				if batchDirective && field.Directives.ForName("batch") != nil {
+					if c.Models[schemaType.Name].Fields == nil {
+						c.Models[schemaType.Name] = TypeMapEntry{
+							Model:  c.Models[schemaType.Name].Model,
+							Fields: map[string]TypeMapField{},
+						}
+					}
+
+					f := c.Models[schemaType.Name].Fields[field.Name]
+					f.Batch = true
+					c.Models[schemaType.Name].Fields[field.Name] = f
+				}
 			}
 		}
 	}
 
+	if err := c.checkBatchFields(); err != nil {
+		return err
+	}
+	return c.checkTimeoutFields()
+}
+
+// injectTimeouts sets the timeout of the fields with a @timeout directive, root fields included
+func (c *Config) injectTimeouts() {
+	for _, schemaType := range c.Schema.Types {
+		if schemaType.Kind != ast.Object {
+			continue
+		}
+		for _, field := range schemaType.Fields {
+			d := field.Directives.ForName("timeout")
+			if d == nil {
+				continue
+			}
+			ms, ok := d.ArgumentMap(nil)["ms"].(int64)
+			if !ok {
+				continue
+			}
+
+			if c.Models[schemaType.Name].Fields == nil {
+				c.Models[schemaType.Name] = TypeMapEntry{
+					Model:  c.Models[schemaType.Name].Model,
+					Fields: map[string]TypeMapField{},
+				}
+			}
+
+			f := c.Models[schemaType.Name].Fields[field.Name]
+			f.Timeout = time.Duration(ms) * time.Millisecond
+			c.Models[schemaType.Name].Fields[field.Name] = f
+		}
+	}
+}
+
+// checkBatchFields makes sure the batched fields belong to objects that can appear in lists
+func (c *Config) checkBatchFields() error {
+	for typeName, entry := range c.Models {
+		for fieldName, field := range entry.Fields {
+			if !field.Batch {
+				continue
+			}
+
+			def := c.Schema.Types[typeName]
+			switch {
+			case def == nil || def.Kind != ast.Object:
+				return fmt.Errorf("%s.%s: only the fields of objects can be batched", typeName, fieldName)
+			case def == c.Schema.Query || def == c.Schema.Mutation || def == c.Schema.Subscription:
+				return fmt.Errorf("%s.%s: the fields of root types cannot be batched", typeName, fieldName)
+			case def.Fields.ForName(fieldName) == nil:
+				return fmt.Errorf("%s.%s: cannot batch a field that is not in the schema", typeName, fieldName)
+			}
+
+			// directives with implementations wrap the resolver of a single object, which batched fields don't have
+			for _, d := range def.Fields.ForName(fieldName).Directives {
+				if !c.Directives[d.Name].SkipRuntime {
+					return fmt.Errorf("%s.%s: batched fields cannot have the @%s directive, which runs at runtime", typeName, fieldName, d.Name)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// checkTimeoutFields makes sure the fields with timeouts have resolvers the timeouts can apply to
+func (c *Config) checkTimeoutFields() error {
+	for typeName, entry := range c.Models {
+		for fieldName, field := range entry.Fields {
+			if field.Timeout == 0 {
+				continue
+			}
+
+			def := c.Schema.Types[typeName]
+			switch {
+			case field.Timeout < 0:
+				return fmt.Errorf("%s.%s: the timeout must be positive", typeName, fieldName)
+			case def == nil || def.Kind != ast.Object:
+				return fmt.Errorf("%s.%s: only the fields of objects can have timeouts", typeName, fieldName)
+			case def == c.Schema.Subscription:
+				return fmt.Errorf("%s.%s: the fields of subscriptions cannot have timeouts", typeName, fieldName)
+			case def.Fields.ForName(fieldName) == nil:
+				return fmt.Errorf("%s.%s: cannot set a timeout on a field that is not in the schema", typeName, fieldName)
+			case field.Batch:
+				return fmt.Errorf("%s.%s: batched fields cannot have timeouts", typeName, fieldName)
+			}
+		}
+	}
 	return nil
 }
 
@@ -306,9 +437,11 @@ type TypeMapEntry struct {
 }
 
 type TypeMapField struct {
-	Resolver        bool   `yaml:"resolver"`
-	FieldName       string `yaml:"fieldName"`
-	GeneratedMethod string `yaml:"-"`
+	Resolver        bool          `yaml:"resolver"`
+	FieldName       string        `yaml:"fieldName"`
+	Batch           bool          `yaml:"batch"`   // resolve the field for all the objects of a list at once, implies resolver
+	Timeout         time.Duration `yaml:"timeout"` // how long the resolver can take before the field fails, implies resolver
+	GeneratedMethod string        `yaml:"-"`
 }
 
 type StringList []string
@@ -389,6 +522,9 @@ func (c *Config) check() error {
 		if err := c.Federation.Check(); err != nil {
 			return fmt.Errorf("config.federation: %w", err)
 		}
+		if c.Federation.Version < 0 || c.Federation.Version > 2 {
+			return fmt.Errorf("config.federation: version must be 1 or 2")
+		}
 		fileList[c.Federation.ImportPath()] = append(fileList[c.Federation.ImportPath()], FilenamePackage{
 			Filename: c.Federation.Filename,
 			Package:  c.Federation.Package,
@@ -466,6 +602,16 @@ func (tm TypeMap) ReferencedPackages() []string {
 	return pkgs
 }
 
+// HasBatchFields tells whether some fields of the type are resolved for all the objects of a list at once
+func (tm TypeMap) HasBatchFields(typeName string) bool {
+	for _, field := range tm[typeName].Fields {
+		if field.Batch {
+			return true
+		}
+	}
+	return false
+}
+
 func (tm TypeMap) Add(name string, goType string) {
 	modelCfg := tm[name]
 	modelCfg.Model = append(modelCfg.Model, goType)
@@ -7,11 +7,13 @@ import (
 	"path/filepath"
 	"runtime"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/vektah/gqlparser/v2"
 	"github.com/vektah/gqlparser/v2/ast"
+	"gopkg.in/yaml.v2"
 
 	"github.com/99designs/gqlgen/internal/code"
 )
@@ -194,3 +196,140 @@ func TestAutobinding(t *testing.T) {
 		require.Equal(t, "github.com/99designs/gqlgen/codegen/config/testdata/autobinding/chat.Message", cfg.Models["Message"].Model[0])
 	})
 }
+
+func TestBatchFields(t *testing.T) {
+	load := func(models TypeMap, schema string) (*Config, error) {
+		cfg := DefaultConfig()
+		cfg.Models = models
+		cfg.Schema = gqlparser.MustLoadSchema(&ast.Source{Name: "TestBatchFields.schema", Input: schema})
+		return cfg, cfg.injectTypesFromSchema()
+	}
+
+	t.Run("from the directive", func(t *testing.T) {
+		cfg, err := load(TypeMap{"User": {Fields: map[string]TypeMapField{"name": {FieldName: "FullName"}}}}, `
+			directive @batch on FIELD_DEFINITION
+			type Query { users: [User!]! }
+			type User {
+				id: ID!
+				name: String! @batch
+			}
+		`)
+		require.NoError(t, err)
+
+		require.True(t, cfg.Directives["batch"].SkipRuntime)
+		require.Equal(t, TypeMapField{FieldName: "FullName", Batch: true}, cfg.Models["User"].Fields["name"])
+		require.True(t, cfg.Models.HasBatchFields("User"))
+	})
+
+	t.Run("a directive configured with the same name is not ours", func(t *testing.T) {
+		cfg := DefaultConfig()
+		cfg.Directives["batch"] = DirectiveConfig{}
+		cfg.Schema = gqlparser.MustLoadSchema(&ast.Source{Name: "TestBatchFields.schema", Input: `
+			directive @batch on FIELD_DEFINITION
+			type Query { users: [User!]! }
+			type User { name: String! @batch }
+		`})
+		require.NoError(t, cfg.injectTypesFromSchema())
+
+		require.False(t, cfg.Directives["batch"].SkipRuntime)
+		require.False(t, cfg.Models.HasBatchFields("User"))
+	})
+
+	t.Run("only on the fields of objects", func(t *testing.T) {
+		schema := `
+			type Query { users: [User!]! }
+			type User { name: String! }
+			interface Node { id: ID! }
+		`
+		_, err := load(TypeMap{"User": {Fields: map[string]TypeMapField{"name": {Batch: true}}}}, schema)
+		require.NoError(t, err)
+
+		_, err = load(TypeMap{"Node": {Fields: map[string]TypeMapField{"id": {Batch: true}}}}, schema)
+		require.EqualError(t, err, "Node.id: only the fields of objects can be batched")
+
+		_, err = load(TypeMap{"Query": {Fields: map[string]TypeMapField{"users": {Batch: true}}}}, schema)
+		require.EqualError(t, err, "Query.users: the fields of root types cannot be batched")
+
+		_, err = load(TypeMap{"User": {Fields: map[string]TypeMapField{"email": {Batch: true}}}}, schema)
+		require.EqualError(t, err, "User.email: cannot batch a field that is not in the schema")
+	})
+
+	t.Run("without runtime directives", func(t *testing.T) {
+		schema := `
+			directive @batch on FIELD_DEFINITION
+			directive @hasRole(role: String!) on FIELD_DEFINITION
+			type Query { users: [User!]! }
+			type User {
+				name: String! @batch
+				email: String! @batch @hasRole(role: "ADMIN")
+			}
+		`
+		_, err := load(TypeMap{}, schema)
+		require.EqualError(t, err, "User.email: batched fields cannot have the @hasRole directive, which runs at runtime")
+
+		cfg := DefaultConfig()
+		cfg.Directives["hasRole"] = DirectiveConfig{SkipRuntime: true}
+		cfg.Schema = gqlparser.MustLoadSchema(&ast.Source{Name: "TestBatchFields.schema", Input: schema})
+		require.NoError(t, cfg.injectTypesFromSchema())
+	})
+}
+
+func TestTimeoutFields(t *testing.T) {
+	load := func(models TypeMap, schema string) (*Config, error) {
+		cfg := DefaultConfig()
+		cfg.Models = models
+		cfg.Schema = gqlparser.MustLoadSchema(&ast.Source{Name: "TestTimeoutFields.schema", Input: schema})
+		return cfg, cfg.injectTypesFromSchema()
+	}
+
+	t.Run("from the directive", func(t *testing.T) {
+		cfg, err := load(TypeMap{}, `
+			directive @timeout(ms: Int!) on FIELD_DEFINITION
+			type Query { user: User @timeout(ms: 1500) }
+			type User { name: String! @timeout(ms: 20) }
+		`)
+		require.NoError(t, err)
+
+		require.True(t, cfg.Directives["timeout"].SkipRuntime)
+		require.Equal(t, 1500*time.Millisecond, cfg.Models["Query"].Fields["user"].Timeout)
+		require.Equal(t, 20*time.Millisecond, cfg.Models["User"].Fields["name"].Timeout)
+	})
+
+	t.Run("from the config", func(t *testing.T) {
+		var models TypeMap
+		require.NoError(t, yaml.Unmarshal([]byte("User:\n  fields:\n    name:\n      timeout: 2s\n"), &models))
+		require.Equal(t, 2*time.Second, models["User"].Fields["name"].Timeout)
+	})
+
+	t.Run("rejects invalid timeout placements", func(t *testing.T) {
+		schema := `
+			type Query { user: User }
+			type Subscription { users: User }
+			type User { name: String! batched: String! }
+			interface Node { id: ID! }
+		`
+		_, err := load(TypeMap{"Node": {Fields: map[string]TypeMapField{"id": {Timeout: time.Second}}}}, schema)
+		require.EqualError(t, err, "Node.id: only the fields of objects can have timeouts")
+
+		_, err = load(TypeMap{"Subscription": {Fields: map[string]TypeMapField{"users": {Timeout: time.Second}}}}, schema)
+		require.EqualError(t, err, "Subscription.users: the fields of subscriptions cannot have timeouts")
+
+		_, err = load(TypeMap{"User": {Fields: map[string]TypeMapField{"email": {Timeout: time.Second}}}}, schema)
+		require.EqualError(t, err, "User.email: cannot set a timeout on a field that is not in the schema")
+
+		_, err = load(TypeMap{"User": {Fields: map[string]TypeMapField{"batched": {Timeout: time.Second, Batch: true}}}}, schema)
+		require.EqualError(t, err, "User.batched: batched fields cannot have timeouts")
+
+		_, err = load(TypeMap{"User": {Fields: map[string]TypeMapField{"name": {Timeout: -time.Second}}}}, schema)
+		require.EqualError(t, err, "User.name: the timeout must be positive")
+	})
+
+	t.Run("on the fields of objects", func(t *testing.T) {
+		cfg, err := load(TypeMap{"User": {Fields: map[string]TypeMapField{"name": {Timeout: time.Second}}}}, `
+			type Query { user: User }
+			type User { name: String! }
+		`)
+		require.NoError(t, err)
+		require.Equal(t, time.Second, cfg.Models["User"].Fields["name"].Timeout)
+	})
+}
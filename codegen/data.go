@@ -7,6 +7,7 @@ import (
 	"github.com/vektah/gqlparser/v2/ast"
 
 	"github.com/99designs/gqlgen/codegen/config"
+	"github.com/99designs/gqlgen/complexity"
 )
 
 // Data is a unified model of the code to be generated. Plugins may modify this structure to do things like implement
@@ -64,6 +65,10 @@ func BuildData(cfg *config.Config) (*Data, error) {
 
 	b.Binder = b.Config.NewBinder()
 
+	if err := complexity.ValidateCostDirectives(b.Schema); err != nil {
+		return nil, err
+	}
+
 	var err error
 	b.Directives, err = b.buildDirectives()
 	if err != nil {
@@ -8,6 +8,7 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/99designs/gqlgen/codegen/config"
 	"github.com/99designs/gqlgen/codegen/templates"
@@ -29,6 +30,8 @@ type Field struct {
 	Object           *Object          // A link back to the parent object
 	Default          interface{}      // The default value
 	Stream           bool             // does this field return a channel?
+	Batch            bool             // Is this field resolved for all the objects of a list at once
+	Timeout          time.Duration    // How long the resolver of this field can take, 0 for no limit
 	Directives       []*Directive
 }
 
@@ -102,6 +105,7 @@ func (b *builder) bindField(obj *Object, f *Field) (errret error) {
 	}()
 
 	f.Stream = obj.Stream
+	f.Timeout = b.Config.Models[obj.Name].Fields[f.Name].Timeout
 
 	switch {
 	case f.Name == "__schema":
@@ -130,7 +134,11 @@ func (b *builder) bindField(obj *Object, f *Field) (errret error) {
 	case obj.Root:
 		f.IsResolver = true
 		return nil
-	case b.Config.Models[obj.Name].Fields[f.Name].Resolver:
+	case b.Config.Models[obj.Name].Fields[f.Name].Batch:
+		f.IsResolver = true
+		f.Batch = true
+		return nil
+	case b.Config.Models[obj.Name].Fields[f.Name].Resolver, f.Timeout > 0:
 		f.IsResolver = true
 		return nil
 	case obj.Type == config.MapType:
@@ -501,7 +509,9 @@ func (f *Field) ShortResolverDeclaration() string {
 
 	res := "(ctx context.Context"
 
-	if !f.Object.Root {
+	if f.Batch {
+		res += fmt.Sprintf(", objs []%s", templates.CurrentImports.LookupType(f.Object.Reference()))
+	} else if !f.Object.Root {
 		res += fmt.Sprintf(", obj %s", templates.CurrentImports.LookupType(f.Object.Reference()))
 	}
 	for _, arg := range f.Args {
@@ -513,10 +523,23 @@ func (f *Field) ShortResolverDeclaration() string {
 		result = "<-chan " + result
 	}
 
+	if f.Batch {
+		res += fmt.Sprintf(") ([]%s, []error)", result)
+		return res
+	}
 	res += fmt.Sprintf(") (%s, error)", result)
 	return res
 }
 
+// BatchInvocation calls the batch method of the field with the objects in objs, and the args parsed in args
+func (f *Field) BatchInvocation(ctx string, objs string) string {
+	args := []string{ctx, objs}
+	for _, arg := range f.Args {
+		args = append(args, "args["+strconv.Quote(arg.Name)+"].("+templates.CurrentImports.LookupType(arg.TypeReference.GO)+")")
+	}
+	return fmt.Sprintf("%s().%s(%s)", strings.Title(f.Object.Definition.Name), f.GoFieldName, strings.Join(args, ", "))
+}
+
 func (f *Field) ComplexitySignature() string {
 	res := "func(childComplexity int"
 	for _, arg := range f.Args {
@@ -107,6 +107,15 @@ func (o *Object) HasResolvers() bool {
 	return false
 }
 
+func (o *Object) HasBatchFields() bool {
+	for _, f := range o.Fields {
+		if f.Batch {
+			return true
+		}
+	}
+	return false
+}
+
 func (o *Object) HasUnmarshal() bool {
 	if o.Type == config.MapType {
 		return true
@@ -0,0 +1,369 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package followschema
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"sync/atomic"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// region    ************************** generated!.gotpl **************************
+
+type BatchParentResolver interface {
+	Sum(ctx context.Context, objs []*BatchParent, add int) ([]int, []error)
+	Name(ctx context.Context, objs []*BatchParent) ([]*string, []error)
+}
+
+// endregion ************************** generated!.gotpl **************************
+
+// region    ***************************** args.gotpl *****************************
+
+func (ec *executionContext) field_BatchParent_sum_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 int
+	if tmp, ok := rawArgs["add"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("add"))
+		arg0, err = ec.unmarshalNInt2int(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["add"] = arg0
+	return args, nil
+}
+
+// endregion ***************************** args.gotpl *****************************
+
+// region    ************************** directives.gotpl **************************
+
+// endregion ************************** directives.gotpl **************************
+
+// region    **************************** field.gotpl *****************************
+
+func (ec *executionContext) _BatchParent_id(ctx context.Context, field graphql.CollectedField, obj *BatchParent) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "BatchParent",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _BatchParent_sum(ctx context.Context, field graphql.CollectedField, obj *BatchParent) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "BatchParent",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_BatchParent_sum_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		if results, i := graphql.GetBatchResults(ctx); results != nil {
+			return results.At(i)
+		}
+		res, errs := ec.resolvers.BatchParent().Sum(rctx, []*BatchParent{obj}, args["add"].(int))
+		return graphql.NewBatchResults("BatchParent.sum", 1, res, errs).At(0)
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _BatchParent_sum_batch(ctx context.Context, field graphql.CollectedField, objs []*BatchParent) (ret *graphql.BatchResults) {
+	defer func() {
+		// each object resolves the field on its own, which reports the error on its path
+		if r := recover(); r != nil {
+			ret = nil
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "BatchParent",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	args, err := ec.field_BatchParent_sum_args(ctx, field.ArgumentMap(ec.Variables))
+	if err != nil {
+		return nil
+	}
+	fc.Args = args
+	res, errs := ec.resolvers.BatchParent().Sum(ctx, objs, args["add"].(int))
+	return graphql.NewBatchResults("BatchParent.sum", len(objs), res, errs)
+}
+
+func (ec *executionContext) _BatchParent_name(ctx context.Context, field graphql.CollectedField, obj *BatchParent) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "BatchParent",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		if results, i := graphql.GetBatchResults(ctx); results != nil {
+			return results.At(i)
+		}
+		res, errs := ec.resolvers.BatchParent().Name(rctx, []*BatchParent{obj})
+		return graphql.NewBatchResults("BatchParent.name", 1, res, errs).At(0)
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _BatchParent_name_batch(ctx context.Context, field graphql.CollectedField, objs []*BatchParent) (ret *graphql.BatchResults) {
+	defer func() {
+		// each object resolves the field on its own, which reports the error on its path
+		if r := recover(); r != nil {
+			ret = nil
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "BatchParent",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	res, errs := ec.resolvers.BatchParent().Name(ctx, objs)
+	return graphql.NewBatchResults("BatchParent.name", len(objs), res, errs)
+}
+
+// endregion **************************** field.gotpl *****************************
+
+// region    **************************** input.gotpl *****************************
+
+// endregion **************************** input.gotpl *****************************
+
+// region    ************************** interface.gotpl ***************************
+
+// endregion ************************** interface.gotpl ***************************
+
+// region    **************************** object.gotpl ****************************
+
+var batchParentImplementors = []string{"BatchParent"}
+
+func (ec *executionContext) _BatchParent(ctx context.Context, sel ast.SelectionSet, obj *BatchParent) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, batchParentImplementors)
+	fields, deferred := graphql.SplitDeferred(fields)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("BatchParent")
+		case "id":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._BatchParent_id(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "sum":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._BatchParent_sum(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "name":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._BatchParent_name(ctx, field, obj)
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.DispatchWith(ec.Scheduler)
+	if invalids > 0 {
+		return graphql.Null
+	}
+	for _, group := range deferred {
+		group := group
+		ec.deferred.Defer(ctx, group.Label, func(ctx context.Context) graphql.Marshaler {
+			return ec._BatchParent(ctx, group.SelectionSet, obj)
+		})
+	}
+	return out
+}
+
+func (ec *executionContext) _BatchParent_batch(ctx context.Context, sel ast.SelectionSet, objs []*BatchParent) *graphql.Batch {
+	batch := graphql.NewBatch()
+	fields, _ := graphql.SplitDeferred(graphql.CollectFields(ec.OperationContext, sel, batchParentImplementors))
+	for _, field := range fields {
+		switch field.Name {
+		case "sum":
+			batch.Set(field.Alias, ec._BatchParent_sum_batch(ctx, field, objs))
+		case "name":
+			batch.Set(field.Alias, ec._BatchParent_name_batch(ctx, field, objs))
+		}
+	}
+	return batch
+}
+
+// endregion **************************** object.gotpl ****************************
+
+// region    ***************************** type.gotpl *****************************
+
+func (ec *executionContext) marshalOBatchParent2ᚕᚖgithubᚗcomᚋ99designsᚋgqlgenᚋcodegenᚋtestserverᚋfollowschemaᚐBatchParent(ctx context.Context, sel ast.SelectionSet, v []*BatchParent) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	n := len(v)
+	ctx, stream := graphql.StreamList(ctx)
+	if stream != nil && stream.InitialCount < n {
+		n = stream.InitialCount
+		ec.deferred.Stream(ctx, stream.Label, n, len(v), func(ctx context.Context, i int) graphql.Marshaler {
+			return ec.marshalOBatchParent2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋcodegenᚋtestserverᚋfollowschemaᚐBatchParent(ctx, sel, v[i])
+		})
+	}
+	ret := make(graphql.Array, n)
+	objs := make([]*BatchParent, 0, n)
+	for i := 0; i < n; i++ {
+		if v[i] != nil {
+			objs = append(objs, v[i])
+		}
+	}
+	batch := ec._BatchParent_batch(ctx, sel, objs)
+	batchIndex := 0
+	var wg sync.WaitGroup
+	isLen1 := n == 1
+	for i := range ret {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		if v[i] != nil {
+			ctx = graphql.WithBatch(ctx, batch, batchIndex)
+			batchIndex++
+		}
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			ret[i] = ec.marshalOBatchParent2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋcodegenᚋtestserverᚋfollowschemaᚐBatchParent(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			ec.Scheduler.Go(&wg, func() { f(i) })
+		}
+
+	}
+	wg.Wait()
+
+	return ret
+}
+
+func (ec *executionContext) marshalOBatchParent2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋcodegenᚋtestserverᚋfollowschemaᚐBatchParent(ctx context.Context, sel ast.SelectionSet, v *BatchParent) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._BatchParent(ctx, sel, v)
+}
+
+// endregion ***************************** type.gotpl *****************************
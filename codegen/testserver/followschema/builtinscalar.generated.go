@@ -72,6 +72,7 @@ var mapImplementors = []string{"Map"}
 
 func (ec *executionContext) _Map(ctx context.Context, sel ast.SelectionSet, obj *Map) graphql.Marshaler {
 	fields := graphql.CollectFields(ec.OperationContext, sel, mapImplementors)
+	fields, deferred := graphql.SplitDeferred(fields)
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
@@ -92,10 +93,16 @@ func (ec *executionContext) _Map(ctx context.Context, sel ast.SelectionSet, obj
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
-	out.Dispatch()
+	out.DispatchWith(ec.Scheduler)
 	if invalids > 0 {
 		return graphql.Null
 	}
+	for _, group := range deferred {
+		group := group
+		ec.deferred.Defer(ctx, group.Label, func(ctx context.Context) graphql.Marshaler {
+			return ec._Map(ctx, group.SelectionSet, obj)
+		})
+	}
 	return out
 }
 
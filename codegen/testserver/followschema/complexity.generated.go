@@ -205,6 +205,7 @@ var overlappingFieldsImplementors = []string{"OverlappingFields"}
 
 func (ec *executionContext) _OverlappingFields(ctx context.Context, sel ast.SelectionSet, obj *OverlappingFields) graphql.Marshaler {
 	fields := graphql.CollectFields(ec.OperationContext, sel, overlappingFieldsImplementors)
+	fields, deferred := graphql.SplitDeferred(fields)
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
@@ -275,10 +276,16 @@ func (ec *executionContext) _OverlappingFields(ctx context.Context, sel ast.Sele
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
-	out.Dispatch()
+	out.DispatchWith(ec.Scheduler)
 	if invalids > 0 {
 		return graphql.Null
 	}
+	for _, group := range deferred {
+		group := group
+		ec.deferred.Defer(ctx, group.Label, func(ctx context.Context) graphql.Marshaler {
+			return ec._OverlappingFields(ctx, group.SelectionSet, obj)
+		})
+	}
 	return out
 }
 
@@ -0,0 +1,113 @@
+package followschema
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConcurrencyLimit(t *testing.T) {
+	var running, concurrency int64
+	resolvers := &Stub{}
+	resolvers.QueryResolver.PrimitiveObject = func(ctx context.Context) ([]Primitive, error) {
+		res := make([]Primitive, 50)
+		for i := range res {
+			res[i] = Primitive(i)
+		}
+		return res, nil
+	}
+	resolvers.PrimitiveResolver.Value = func(ctx context.Context, obj *Primitive) (int, error) {
+		now := atomic.AddInt64(&running, 1)
+		for {
+			peak := atomic.LoadInt64(&concurrency)
+			if now <= peak || atomic.CompareAndSwapInt64(&concurrency, peak, now) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		atomic.AddInt64(&running, -1)
+		return int(*obj), nil
+	}
+	resolvers.QueryResolver.PrimitiveStringObject = func(ctx context.Context) ([]PrimitiveString, error) {
+		return []PrimitiveString{"a"}, nil
+	}
+	resolvers.PrimitiveStringResolver.Value = func(ctx context.Context, obj *PrimitiveString) (string, error) {
+		return string(*obj), nil
+	}
+	resolvers.PrimitiveStringResolver.Len = func(ctx context.Context, obj *PrimitiveString) (int, error) {
+		return len(*obj), nil
+	}
+
+	newServer := func() (*handler.Server, *graphql.SchedulerStats) {
+		srv := handler.NewDefaultServer(NewExecutableSchema(Config{Resolvers: resolvers}))
+		scheduler := &graphql.SchedulerStats{}
+		srv.AroundResponses(func(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+			resp := next(ctx)
+			*scheduler = *graphql.GetSchedulerStats(ctx)
+			return resp
+		})
+		return srv, scheduler
+	}
+
+	var resp struct {
+		PrimitiveObject []struct{ Value, Squared int }
+	}
+
+	t.Run("starts a goroutine per item without limit", func(t *testing.T) {
+		srv, scheduler := newServer()
+		client.New(srv).MustPost(`{ primitiveObject { value squared } }`, &resp)
+
+		require.Len(t, resp.PrimitiveObject, 50)
+		require.Equal(t, 49, resp.PrimitiveObject[49].Value)
+		require.Equal(t, 50, scheduler.Started)
+	})
+
+	t.Run("limits the goroutines of operations", func(t *testing.T) {
+		concurrency = 0
+		srv, scheduler := newServer()
+		srv.SetConcurrencyLimit(4)
+		client.New(srv).MustPost(`{ primitiveObject { value squared } }`, &resp)
+
+		require.Len(t, resp.PrimitiveObject, 50)
+		require.Equal(t, 49*49, resp.PrimitiveObject[49].Squared)
+		require.LessOrEqual(t, scheduler.Peak, 4)
+		require.LessOrEqual(t, concurrency, int64(5), "the goroutines and the one resolving the list")
+	})
+
+	t.Run("limits the goroutines of the server", func(t *testing.T) {
+		concurrency = 0
+		srv, scheduler := newServer()
+		srv.SetServerConcurrencyLimit(2)
+		client.New(srv).MustPost(`{ primitiveObject { value } }`, &resp)
+
+		require.Len(t, resp.PrimitiveObject, 50)
+		require.LessOrEqual(t, scheduler.Peak, 2)
+	})
+
+	t.Run("resolves inline fields without goroutines", func(t *testing.T) {
+		var resp struct {
+			PrimitiveStringObject []struct {
+				Value string
+				Len   int
+			}
+		}
+
+		srv, scheduler := newServer()
+		client.New(srv).MustPost(`{ primitiveStringObject { value len } }`, &resp)
+		require.Equal(t, 1, scheduler.Started)
+
+		srv, scheduler = newServer()
+		srv.SetInlineFields(func(field graphql.CollectedField) bool {
+			return field.Name == "len"
+		})
+		client.New(srv).MustPost(`{ primitiveStringObject { value len } }`, &resp)
+		require.Equal(t, 0, scheduler.Started)
+		require.Equal(t, 1, resp.PrimitiveStringObject[0].Len)
+	})
+}
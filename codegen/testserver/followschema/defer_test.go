@@ -0,0 +1,164 @@
+package followschema
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefer(t *testing.T) {
+	resolvers := &Stub{}
+	resolvers.QueryResolver.DeferCase1 = func(ctx context.Context) (*DeferModel, error) {
+		return &DeferModel{ID: "1", Name: "Defer test 1"}, nil
+	}
+	resolvers.QueryResolver.DeferCase2 = func(ctx context.Context) ([]*DeferModel, error) {
+		return []*DeferModel{
+			{ID: "1", Name: "Defer test 1"},
+			{ID: "2", Name: "Defer test 2"},
+			{ID: "3", Name: "Defer test 3"},
+		}, nil
+	}
+	resolvers.DeferModelResolver.Values = func(ctx context.Context, obj *DeferModel) ([]string, error) {
+		return []string{"test defer 1", "test defer 2", "test defer 3"}, nil
+	}
+
+	srv := handler.NewDefaultServer(NewExecutableSchema(Config{Resolvers: resolvers}))
+
+	t.Run("defer inline fragment", func(t *testing.T) {
+		payloads := doIncremental(t, srv, `query { deferCase1 { id ... @defer(label: "values") { values } } }`)
+
+		require.Equal(t, `{"data":{"deferCase1":{"id":"1"}},"hasNext":true}`, payloads[0])
+		require.Equal(t, `{"incremental":[{"data":{"values":["test defer 1","test defer 2","test defer 3"]},"path":["deferCase1"],"label":"values"}],"hasNext":false}`, payloads[1])
+		require.Len(t, payloads, 2)
+	})
+
+	t.Run("defer fragment spread on list items", func(t *testing.T) {
+		payloads := doIncremental(t, srv, `query { deferCase2 { id ...Name @defer } } fragment Name on DeferModel { name }`)
+
+		require.Equal(t, `{"data":{"deferCase2":[{"id":"1"},{"id":"2"},{"id":"3"}]},"hasNext":true}`, payloads[0])
+		require.Equal(t, []string{
+			`{"data":{"name":"Defer test 1"},"path":["deferCase2",0]}`,
+			`{"data":{"name":"Defer test 2"},"path":["deferCase2",1]}`,
+			`{"data":{"name":"Defer test 3"},"path":["deferCase2",2]}`,
+		}, incrementalResults(t, payloads[1:]))
+	})
+
+	t.Run("fields selected outside of the deferred fragment are not deferred", func(t *testing.T) {
+		payloads := doIncremental(t, srv, `query { deferCase1 { id name ... @defer { id name values } } }`)
+
+		require.Equal(t, `{"data":{"deferCase1":{"id":"1","name":"Defer test 1"}},"hasNext":true}`, payloads[0])
+		require.Equal(t, []string{
+			`{"data":{"values":["test defer 1","test defer 2","test defer 3"]},"path":["deferCase1"]}`,
+		}, incrementalResults(t, payloads[1:]))
+	})
+
+	t.Run("defer can be disabled", func(t *testing.T) {
+		payloads := doIncremental(t, srv, `query { deferCase1 { id ... @defer(if: false) { name } } }`)
+
+		require.Equal(t, []string{`{"data":{"deferCase1":{"id":"1","name":"Defer test 1"}}}`}, payloads)
+	})
+
+	t.Run("stream list items", func(t *testing.T) {
+		payloads := doIncremental(t, srv, `query { deferCase2 @stream(initialCount: 1, label: "models") { id } }`)
+
+		require.Equal(t, `{"data":{"deferCase2":[{"id":"1"}]},"hasNext":true}`, payloads[0])
+		require.Equal(t, []string{
+			`{"items":[{"id":"2"}],"path":["deferCase2",1],"label":"models"}`,
+			`{"items":[{"id":"3"}],"path":["deferCase2",2],"label":"models"}`,
+		}, incrementalResults(t, payloads[1:]))
+	})
+
+	t.Run("stream scalar list", func(t *testing.T) {
+		payloads := doIncremental(t, srv, `query { deferCase1 { values @stream(initialCount: 2) } }`)
+
+		require.Equal(t, `{"data":{"deferCase1":{"values":["test defer 1","test defer 2"]}},"hasNext":true}`, payloads[0])
+		require.Equal(t, []string{
+			`{"items":["test defer 3"],"path":["deferCase1","values",2]}`,
+		}, incrementalResults(t, payloads[1:]))
+	})
+
+	t.Run("incremental delivery is ignored unless the client accepts multipart responses", func(t *testing.T) {
+		r := httptest.NewRequest("POST", "/", strings.NewReader(`{"query":"query { deferCase1 { id ... @defer { name } } deferCase2 @stream { id } }"}`))
+		r.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		srv.ServeHTTP(w, r)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Equal(t, "application/json", w.Header().Get("Content-Type"))
+		require.Equal(t, `{"data":{"deferCase1":{"id":"1","name":"Defer test 1"},"deferCase2":[{"id":"1"},{"id":"2"},{"id":"3"}]}}`, w.Body.String())
+	})
+}
+
+// doIncremental runs a query accepting a multipart/mixed response, and returns the body of each part.
+func doIncremental(t *testing.T, srv http.Handler, query string) []string {
+	body, err := json.Marshal(map[string]string{"query": query})
+	require.NoError(t, err)
+
+	r := httptest.NewRequest("POST", "/", strings.NewReader(string(body)))
+	r.Header.Set("Content-Type", "application/json")
+	r.Header.Set("Accept", "multipart/mixed; deferSpec=20220824, application/json")
+	w := httptest.NewRecorder()
+	srv.ServeHTTP(w, r)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	mediaType, params, err := mime.ParseMediaType(w.Header().Get("Content-Type"))
+	require.NoError(t, err)
+	if mediaType == "application/json" {
+		return []string{w.Body.String()}
+	}
+	require.Equal(t, "multipart/mixed", mediaType)
+
+	var payloads []string
+	mr := multipart.NewReader(w.Body, params["boundary"])
+	for {
+		part, err := mr.NextPart()
+		if err != nil {
+			break
+		}
+		require.Equal(t, "application/json; charset=utf-8", part.Header.Get("Content-Type"))
+		b, err := ioutil.ReadAll(part)
+		require.NoError(t, err)
+		payloads = append(payloads, string(b))
+	}
+	return payloads
+}
+
+// incrementalResults returns the incremental results of subsequent payloads sorted by path, checking that only the
+// last payload has hasNext set to false.
+func incrementalResults(t *testing.T, payloads []string) []string {
+	var results []string
+	for i, payload := range payloads {
+		var resp struct {
+			Incremental []json.RawMessage `json:"incremental"`
+			HasNext     bool              `json:"hasNext"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(payload), &resp))
+		require.Equal(t, i < len(payloads)-1, resp.HasNext, payload)
+		for _, result := range resp.Incremental {
+			results = append(results, string(result))
+		}
+	}
+	sort.Slice(results, func(i, j int) bool {
+		return fmt.Sprint(pathOf(results[i])) < fmt.Sprint(pathOf(results[j]))
+	})
+	return results
+}
+
+func pathOf(result string) []interface{} {
+	var r struct {
+		Path []interface{} `json:"path"`
+	}
+	_ = json.Unmarshal([]byte(result), &r)
+	return r.Path
+}
@@ -70,6 +70,7 @@ var checkIssue896Implementors = []string{"CheckIssue896"}
 
 func (ec *executionContext) _CheckIssue896(ctx context.Context, sel ast.SelectionSet, obj *CheckIssue896) graphql.Marshaler {
 	fields := graphql.CollectFields(ec.OperationContext, sel, checkIssue896Implementors)
+	fields, deferred := graphql.SplitDeferred(fields)
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
@@ -87,10 +88,16 @@ func (ec *executionContext) _CheckIssue896(ctx context.Context, sel ast.Selectio
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
-	out.Dispatch()
+	out.DispatchWith(ec.Scheduler)
 	if invalids > 0 {
 		return graphql.Null
 	}
+	for _, group := range deferred {
+		group := group
+		ec.deferred.Defer(ctx, group.Label, func(ctx context.Context) graphql.Marshaler {
+			return ec._CheckIssue896(ctx, group.SelectionSet, obj)
+		})
+	}
 	return out
 }
 
@@ -112,13 +119,18 @@ func (ec *executionContext) marshalOCheckIssue8962ᚕᚖgithubᚗcomᚋ99designs
 	if v == nil {
 		return graphql.Null
 	}
-	ret := make(graphql.Array, len(v))
-	var wg sync.WaitGroup
-	isLen1 := len(v) == 1
-	if !isLen1 {
-		wg.Add(len(v))
+	n := len(v)
+	ctx, stream := graphql.StreamList(ctx)
+	if stream != nil && stream.InitialCount < n {
+		n = stream.InitialCount
+		ec.deferred.Stream(ctx, stream.Label, n, len(v), func(ctx context.Context, i int) graphql.Marshaler {
+			return ec.marshalOCheckIssue8962ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋcodegenᚋtestserverᚋfollowschemaᚐCheckIssue896(ctx, sel, v[i])
+		})
 	}
-	for i := range v {
+	ret := make(graphql.Array, n)
+	var wg sync.WaitGroup
+	isLen1 := n == 1
+	for i := range ret {
 		i := i
 		fc := &graphql.FieldContext{
 			Index:  &i,
@@ -132,15 +144,12 @@ func (ec *executionContext) marshalOCheckIssue8962ᚕᚖgithubᚗcomᚋ99designs
 					ret = nil
 				}
 			}()
-			if !isLen1 {
-				defer wg.Done()
-			}
 			ret[i] = ec.marshalOCheckIssue8962ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋcodegenᚋtestserverᚋfollowschemaᚐCheckIssue896(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
 		} else {
-			go f(i)
+			ec.Scheduler.Go(&wg, func() { f(i) })
 		}
 
 	}
@@ -153,13 +162,18 @@ func (ec *executionContext) marshalOCheckIssue8962ᚕᚖgithubᚗcomᚋ99designs
 	if v == nil {
 		return graphql.Null
 	}
-	ret := make(graphql.Array, len(v))
-	var wg sync.WaitGroup
-	isLen1 := len(v) == 1
-	if !isLen1 {
-		wg.Add(len(v))
+	n := len(v)
+	ctx, stream := graphql.StreamList(ctx)
+	if stream != nil && stream.InitialCount < n {
+		n = stream.InitialCount
+		ec.deferred.Stream(ctx, stream.Label, n, len(v), func(ctx context.Context, i int) graphql.Marshaler {
+			return ec.marshalNCheckIssue8962ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋcodegenᚋtestserverᚋfollowschemaᚐCheckIssue896(ctx, sel, v[i])
+		})
 	}
-	for i := range v {
+	ret := make(graphql.Array, n)
+	var wg sync.WaitGroup
+	isLen1 := n == 1
+	for i := range ret {
 		i := i
 		fc := &graphql.FieldContext{
 			Index:  &i,
@@ -173,15 +187,12 @@ func (ec *executionContext) marshalOCheckIssue8962ᚕᚖgithubᚗcomᚋ99designs
 					ret = nil
 				}
 			}()
-			if !isLen1 {
-				defer wg.Done()
-			}
 			ret[i] = ec.marshalNCheckIssue8962ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋcodegenᚋtestserverᚋfollowschemaᚐCheckIssue896(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
 		} else {
-			go f(i)
+			ec.Scheduler.Go(&wg, func() { f(i) })
 		}
 
 	}
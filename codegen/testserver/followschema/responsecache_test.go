@@ -0,0 +1,128 @@
+package followschema
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResponseCache(t *testing.T) {
+	posts := map[int]*CachedPost{1: {ID: 1, Views: 10}, 2: {ID: 2, Views: 20}}
+	resolvers := &Stub{}
+	resolvers.QueryResolver.CachedPost = func(ctx context.Context) (*CachedPost, error) {
+		return posts[1], nil
+	}
+	resolvers.QueryResolver.CachedPosts = func(ctx context.Context) ([]*CachedPost, error) {
+		return []*CachedPost{posts[1], posts[2]}, nil
+	}
+	resolvers.QueryResolver.TypeHintedPost = func(ctx context.Context) (*CachedPost, error) {
+		return posts[2], nil
+	}
+	resolvers.MutationResolver.ViewCachedPost = func(ctx context.Context) (*CachedPost, error) {
+		posts[2] = &CachedPost{ID: 2, Views: posts[2].Views + 1}
+		return posts[2], nil
+	}
+
+	user := "alice"
+	cache := extension.ResponseCache{
+		Store: extension.NewInMemoryResponseStore(100),
+		TTL:   time.Minute,
+		Scope: func(ctx context.Context) string {
+			return user
+		},
+	}
+	srv := handler.NewDefaultServer(NewExecutableSchema(Config{Resolvers: resolvers}))
+	srv.Use(cache)
+	srv.Use(&extension.CacheControl{})
+	c := client.New(srv)
+
+	hit := func(t *testing.T, query string) bool {
+		resp, err := c.RawPost(query)
+		require.NoError(t, err)
+		require.Nil(t, resp.Errors)
+		return resp.Extensions["responseCache"].(map[string]interface{})["hit"].(bool)
+	}
+
+	t.Run("caches queries", func(t *testing.T) {
+		require.False(t, hit(t, `{ cachedPosts { id views } }`))
+		require.True(t, hit(t, `{ cachedPosts { id views } }`))
+	})
+
+	t.Run("sets the cache control header of hits", func(t *testing.T) {
+		post := func(query string) *httptest.ResponseRecorder {
+			r := httptest.NewRequest("POST", "/query", strings.NewReader(`{"query":"`+query+`"}`))
+			r.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			srv.ServeHTTP(w, r)
+			require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+			return w
+		}
+
+		resp := post(`{ typeHintedPost { id } }`)
+		require.JSONEq(t, `{"data":{"typeHintedPost":{"id":2}},"extensions":{"responseCache":{"hit":false}}}`, resp.Body.String())
+		require.Equal(t, "max-age=30, public", resp.Header().Get("Cache-Control"))
+
+		resp = post(`{ typeHintedPost { id } }`)
+		require.JSONEq(t, `{"data":{"typeHintedPost":{"id":2}},"extensions":{"responseCache":{"hit":true}}}`, resp.Body.String())
+		require.Equal(t, "max-age=30, public", resp.Header().Get("Cache-Control"))
+
+		resp = post(`{ cachedPost { id draft } }`)
+		require.Equal(t, "max-age=120, private", resp.Header().Get("Cache-Control"))
+		resp = post(`{ cachedPost { id draft } }`)
+		require.JSONEq(t, `{"data":{"cachedPost":{"id":1,"draft":null}},"extensions":{"responseCache":{"hit":true}}}`, resp.Body.String())
+		require.Equal(t, "max-age=120, private", resp.Header().Get("Cache-Control"))
+	})
+
+	t.Run("does not cache responses that can't be cached", func(t *testing.T) {
+		require.False(t, hit(t, `{ cachedPost { editor { name } } }`))
+		require.False(t, hit(t, `{ cachedPost { editor { name } } }`))
+	})
+
+	t.Run("caches private responses per scope", func(t *testing.T) {
+		require.False(t, hit(t, `{ cachedPost { draft } }`))
+		require.True(t, hit(t, `{ cachedPost { draft } }`))
+
+		user = "bob"
+		require.False(t, hit(t, `{ cachedPost { draft } }`))
+
+		user = ""
+		require.False(t, hit(t, `{ cachedPost { draft } }`))
+		require.False(t, hit(t, `{ cachedPost { draft } }`))
+		user = "alice"
+	})
+
+	t.Run("mutations invalidate the objects they return", func(t *testing.T) {
+		require.False(t, hit(t, `{ cachedPost { id } }`))
+		require.True(t, hit(t, `{ cachedPost { id } }`))
+		require.True(t, hit(t, `{ cachedPosts { id views } }`))
+
+		var resp struct {
+			ViewCachedPost struct{ ID, Views int }
+		}
+		c.MustPost(`mutation { viewCachedPost { id views } }`, &resp)
+		require.Equal(t, 21, resp.ViewCachedPost.Views)
+
+		require.True(t, hit(t, `{ cachedPost { id } }`))
+		raw, err := c.RawPost(`{ cachedPosts { id views } }`)
+		require.NoError(t, err)
+		require.Equal(t, false, raw.Extensions["responseCache"].(map[string]interface{})["hit"])
+		views, err := json.Marshal(raw.Data)
+		require.NoError(t, err)
+		require.JSONEq(t, `{"cachedPosts":[{"id":1,"views":10},{"id":2,"views":21}]}`, string(views))
+	})
+
+	t.Run("invalidates types", func(t *testing.T) {
+		require.True(t, hit(t, `{ cachedPost { id } }`))
+		cache.Invalidate(context.Background(), "CachedPost")
+		require.False(t, hit(t, `{ cachedPost { id } }`))
+	})
+}
@@ -30,6 +30,8 @@ type Config struct {
 
 type ResolverRoot interface {
 	BackedByInterface() BackedByInterfaceResolver
+	BatchParent() BatchParentResolver
+	DeferModel() DeferModelResolver
 	Errors() ErrorsResolver
 	ForcedResolver() ForcedResolverResolver
 	ModelMethods() ModelMethodsResolver
@@ -39,6 +41,7 @@ type ResolverRoot interface {
 	Primitive() PrimitiveResolver
 	PrimitiveString() PrimitiveStringResolver
 	Query() QueryResolver
+	SlowObject() SlowObjectResolver
 	Subscription() SubscriptionResolver
 	User() UserResolver
 	WrappedMap() WrappedMapResolver
@@ -92,6 +95,24 @@ type ComplexityRoot struct {
 		ThisShouldBindWithError func(childComplexity int) int
 	}
 
+	BatchParent struct {
+		ID   func(childComplexity int) int
+		Name func(childComplexity int) int
+		Sum  func(childComplexity int, add int) int
+	}
+
+	CachedAuthor struct {
+		Name func(childComplexity int) int
+	}
+
+	CachedPost struct {
+		Author func(childComplexity int) int
+		Draft  func(childComplexity int) int
+		Editor func(childComplexity int) int
+		ID     func(childComplexity int) int
+		Views  func(childComplexity int) int
+	}
+
 	Cat struct {
 		CatBreed func(childComplexity int) int
 		Species  func(childComplexity int) int
@@ -136,6 +157,12 @@ type ComplexityRoot struct {
 		TruthyBoolean func(childComplexity int) int
 	}
 
+	DeferModel struct {
+		ID     func(childComplexity int) int
+		Name   func(childComplexity int) int
+		Values func(childComplexity int) int
+	}
+
 	Dog struct {
 		DogBreed func(childComplexity int) int
 		Species  func(childComplexity int) int
@@ -220,6 +247,7 @@ type ComplexityRoot struct {
 		DefaultInput    func(childComplexity int, input DefaultInput) int
 		UpdatePtrToPtr  func(childComplexity int, input UpdatePtrToPtrOuter) int
 		UpdateSomething func(childComplexity int, input SpecialInput) int
+		ViewCachedPost  func(childComplexity int) int
 	}
 
 	ObjectDirectives struct {
@@ -277,9 +305,15 @@ type ComplexityRoot struct {
 	Query struct {
 		Animal                           func(childComplexity int) int
 		Autobind                         func(childComplexity int) int
+		BatchParent                      func(childComplexity int) int
+		BatchParents                     func(childComplexity int) int
+		CachedPost                       func(childComplexity int) int
+		CachedPosts                      func(childComplexity int) int
 		Collision                        func(childComplexity int) int
 		DefaultParameters                func(childComplexity int, falsyBoolean *bool, truthyBoolean *bool) int
 		DefaultScalar                    func(childComplexity int, arg string) int
+		DeferCase1                       func(childComplexity int) int
+		DeferCase2                       func(childComplexity int) int
 		DeprecatedField                  func(childComplexity int) int
 		DirectiveArg                     func(childComplexity int, arg string) int
 		DirectiveDouble                  func(childComplexity int) int
@@ -328,8 +362,11 @@ type ComplexityRoot struct {
 		ShapeUnion                       func(childComplexity int) int
 		Shapes                           func(childComplexity int) int
 		Slices                           func(childComplexity int) int
+		SlowObject                       func(childComplexity int) int
+		SlowRoot                         func(childComplexity int) int
 		StringFromContextFunction        func(childComplexity int) int
 		StringFromContextInterface       func(childComplexity int) int
+		TypeHintedPost                   func(childComplexity int) int
 		User                             func(childComplexity int, id int) int
 		VOkCaseNil                       func(childComplexity int) int
 		VOkCaseValue                     func(childComplexity int) int
@@ -355,6 +392,14 @@ type ComplexityRoot struct {
 		Test4 func(childComplexity int) int
 	}
 
+	SlowObject struct {
+		Configured     func(childComplexity int) int
+		Fast           func(childComplexity int) int
+		Slow           func(childComplexity int) int
+		SlowRequired   func(childComplexity int) int
+		SubMillisecond func(childComplexity int) int
+	}
+
 	Subscription struct {
 		DirectiveArg           func(childComplexity int, arg string) int
 		DirectiveDouble        func(childComplexity int) int
@@ -428,7 +473,7 @@ func (e *executableSchema) Schema() *ast.Schema {
 }
 
 func (e *executableSchema) Complexity(typeName, field string, childComplexity int, rawArgs map[string]interface{}) (int, bool) {
-	ec := executionContext{nil, e}
+	ec := executionContext{nil, e, nil}
 	_ = ec
 	switch typeName + "." + field {
 
@@ -516,6 +561,74 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.BackedByInterface.ThisShouldBindWithError(childComplexity), true
 
+	case "BatchParent.id":
+		if e.complexity.BatchParent.ID == nil {
+			break
+		}
+
+		return e.complexity.BatchParent.ID(childComplexity), true
+
+	case "BatchParent.name":
+		if e.complexity.BatchParent.Name == nil {
+			break
+		}
+
+		return e.complexity.BatchParent.Name(childComplexity), true
+
+	case "BatchParent.sum":
+		if e.complexity.BatchParent.Sum == nil {
+			break
+		}
+
+		args, err := ec.field_BatchParent_sum_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.BatchParent.Sum(childComplexity, args["add"].(int)), true
+
+	case "CachedAuthor.name":
+		if e.complexity.CachedAuthor.Name == nil {
+			break
+		}
+
+		return e.complexity.CachedAuthor.Name(childComplexity), true
+
+	case "CachedPost.author":
+		if e.complexity.CachedPost.Author == nil {
+			break
+		}
+
+		return e.complexity.CachedPost.Author(childComplexity), true
+
+	case "CachedPost.draft":
+		if e.complexity.CachedPost.Draft == nil {
+			break
+		}
+
+		return e.complexity.CachedPost.Draft(childComplexity), true
+
+	case "CachedPost.editor":
+		if e.complexity.CachedPost.Editor == nil {
+			break
+		}
+
+		return e.complexity.CachedPost.Editor(childComplexity), true
+
+	case "CachedPost.id":
+		if e.complexity.CachedPost.ID == nil {
+			break
+		}
+
+		return e.complexity.CachedPost.ID(childComplexity), true
+
+	case "CachedPost.views":
+		if e.complexity.CachedPost.Views == nil {
+			break
+		}
+
+		return e.complexity.CachedPost.Views(childComplexity), true
+
 	case "Cat.catBreed":
 		if e.complexity.Cat.CatBreed == nil {
 			break
@@ -635,6 +748,27 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.DefaultParametersMirror.TruthyBoolean(childComplexity), true
 
+	case "DeferModel.id":
+		if e.complexity.DeferModel.ID == nil {
+			break
+		}
+
+		return e.complexity.DeferModel.ID(childComplexity), true
+
+	case "DeferModel.name":
+		if e.complexity.DeferModel.Name == nil {
+			break
+		}
+
+		return e.complexity.DeferModel.Name(childComplexity), true
+
+	case "DeferModel.values":
+		if e.complexity.DeferModel.Values == nil {
+			break
+		}
+
+		return e.complexity.DeferModel.Values(childComplexity), true
+
 	case "Dog.dogBreed":
 		if e.complexity.Dog.DogBreed == nil {
 			break
@@ -874,6 +1008,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.UpdateSomething(childComplexity, args["input"].(SpecialInput)), true
 
+	case "Mutation.viewCachedPost":
+		if e.complexity.Mutation.ViewCachedPost == nil {
+			break
+		}
+
+		return e.complexity.Mutation.ViewCachedPost(childComplexity), true
+
 	case "ObjectDirectives.nullableText":
 		if e.complexity.ObjectDirectives.NullableText == nil {
 			break
@@ -1052,6 +1193,34 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.Autobind(childComplexity), true
 
+	case "Query.batchParent":
+		if e.complexity.Query.BatchParent == nil {
+			break
+		}
+
+		return e.complexity.Query.BatchParent(childComplexity), true
+
+	case "Query.batchParents":
+		if e.complexity.Query.BatchParents == nil {
+			break
+		}
+
+		return e.complexity.Query.BatchParents(childComplexity), true
+
+	case "Query.cachedPost":
+		if e.complexity.Query.CachedPost == nil {
+			break
+		}
+
+		return e.complexity.Query.CachedPost(childComplexity), true
+
+	case "Query.cachedPosts":
+		if e.complexity.Query.CachedPosts == nil {
+			break
+		}
+
+		return e.complexity.Query.CachedPosts(childComplexity), true
+
 	case "Query.collision":
 		if e.complexity.Query.Collision == nil {
 			break
@@ -1083,6 +1252,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.DefaultScalar(childComplexity, args["arg"].(string)), true
 
+	case "Query.deferCase1":
+		if e.complexity.Query.DeferCase1 == nil {
+			break
+		}
+
+		return e.complexity.Query.DeferCase1(childComplexity), true
+
+	case "Query.deferCase2":
+		if e.complexity.Query.DeferCase2 == nil {
+			break
+		}
+
+		return e.complexity.Query.DeferCase2(childComplexity), true
+
 	case "Query.deprecatedField":
 		if e.complexity.Query.DeprecatedField == nil {
 			break
@@ -1499,6 +1682,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.Slices(childComplexity), true
 
+	case "Query.slowObject":
+		if e.complexity.Query.SlowObject == nil {
+			break
+		}
+
+		return e.complexity.Query.SlowObject(childComplexity), true
+
+	case "Query.slowRoot":
+		if e.complexity.Query.SlowRoot == nil {
+			break
+		}
+
+		return e.complexity.Query.SlowRoot(childComplexity), true
+
 	case "Query.stringFromContextFunction":
 		if e.complexity.Query.StringFromContextFunction == nil {
 			break
@@ -1513,6 +1710,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.StringFromContextInterface(childComplexity), true
 
+	case "Query.typeHintedPost":
+		if e.complexity.Query.TypeHintedPost == nil {
+			break
+		}
+
+		return e.complexity.Query.TypeHintedPost(childComplexity), true
+
 	case "Query.user":
 		if e.complexity.Query.User == nil {
 			break
@@ -1637,6 +1841,41 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Slices.Test4(childComplexity), true
 
+	case "SlowObject.configured":
+		if e.complexity.SlowObject.Configured == nil {
+			break
+		}
+
+		return e.complexity.SlowObject.Configured(childComplexity), true
+
+	case "SlowObject.fast":
+		if e.complexity.SlowObject.Fast == nil {
+			break
+		}
+
+		return e.complexity.SlowObject.Fast(childComplexity), true
+
+	case "SlowObject.slow":
+		if e.complexity.SlowObject.Slow == nil {
+			break
+		}
+
+		return e.complexity.SlowObject.Slow(childComplexity), true
+
+	case "SlowObject.slowRequired":
+		if e.complexity.SlowObject.SlowRequired == nil {
+			break
+		}
+
+		return e.complexity.SlowObject.SlowRequired(childComplexity), true
+
+	case "SlowObject.subMillisecond":
+		if e.complexity.SlowObject.SubMillisecond == nil {
+			break
+		}
+
+		return e.complexity.SlowObject.SubMillisecond(childComplexity), true
+
 	case "Subscription.directiveArg":
 		if e.complexity.Subscription.DirectiveArg == nil {
 			break
@@ -1848,36 +2087,50 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 	rc := graphql.GetOperationContext(ctx)
-	ec := executionContext{rc, e}
+	ec := executionContext{rc, e, &graphql.DeferredResults{}}
 	first := true
 
 	switch rc.Operation.Operation {
 	case ast.Query:
 		return func(ctx context.Context) *graphql.Response {
 			if !first {
-				return nil
+				return ec.deferred.Next(ctx)
 			}
 			first = false
 			data := ec._Query(ctx, rc.Operation.SelectionSet)
+			if rc.StreamData {
+				return &graphql.Response{
+					StreamedData: data,
+					HasNext:      ec.deferred.HasNext(),
+				}
+			}
 			var buf bytes.Buffer
 			data.MarshalGQL(&buf)
 
 			return &graphql.Response{
-				Data: buf.Bytes(),
+				Data:    buf.Bytes(),
+				HasNext: ec.deferred.HasNext(),
 			}
 		}
 	case ast.Mutation:
 		return func(ctx context.Context) *graphql.Response {
 			if !first {
-				return nil
+				return ec.deferred.Next(ctx)
 			}
 			first = false
 			data := ec._Mutation(ctx, rc.Operation.SelectionSet)
+			if rc.StreamData {
+				return &graphql.Response{
+					StreamedData: data,
+					HasNext:      ec.deferred.HasNext(),
+				}
+			}
 			var buf bytes.Buffer
 			data.MarshalGQL(&buf)
 
 			return &graphql.Response{
-				Data: buf.Bytes(),
+				Data:    buf.Bytes(),
+				HasNext: ec.deferred.HasNext(),
 			}
 		}
 	case ast.Subscription:
@@ -1906,6 +2159,7 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 type executionContext struct {
 	*graphql.OperationContext
 	*executableSchema
+	deferred *graphql.DeferredResults
 }
 
 func (ec *executionContext) introspectSchema() (*introspection.Schema, error) {
@@ -1923,6 +2177,19 @@ func (ec *executionContext) introspectType(name string) (*introspection.Type, er
 }
 
 var sources = []*ast.Source{
+	{Name: "batch.graphql", Input: `directive @batch on FIELD_DEFINITION
+
+extend type Query {
+    batchParents: [BatchParent]
+    batchParent: BatchParent
+}
+
+type BatchParent {
+    id: Int!
+    sum(add: Int! = 0): Int! @batch
+    name: String
+}
+`, BuiltIn: false},
 	{Name: "builtinscalar.graphql", Input: `
 """
 Since gqlgen defines default implementation for a Map scalar, this tests that the builtin is _not_
@@ -1931,6 +2198,35 @@ added to the TypeMap
 type Map {
     id: ID!
 }
+`, BuiltIn: false},
+	{Name: "cachecontrol.graphql", Input: `directive @cacheControl(maxAge: Int, scope: CacheControlScope, inheritMaxAge: Boolean) on FIELD_DEFINITION | OBJECT | INTERFACE | UNION
+
+enum CacheControlScope {
+    PUBLIC
+    PRIVATE
+}
+
+extend type Query {
+    cachedPost: CachedPost @cacheControl(maxAge: 120)
+    cachedPosts: [CachedPost!]! @cacheControl(maxAge: 60)
+    typeHintedPost: CachedPost
+}
+
+extend type Mutation {
+    viewCachedPost: CachedPost
+}
+
+type CachedPost @cacheControl(maxAge: 30) {
+    id: Int!
+    views: Int! @cacheControl(maxAge: 5)
+    draft: String @cacheControl(scope: PRIVATE)
+    author: CachedAuthor @cacheControl(inheritMaxAge: true)
+    editor: CachedAuthor
+}
+
+type CachedAuthor {
+    name: String!
+}
 `, BuiltIn: false},
 	{Name: "complexity.graphql", Input: `extend type Query {
     overlapping: OverlappingFields
@@ -1964,6 +2260,20 @@ type DefaultParametersMirror {
     falsyBoolean: Boolean
     truthyBoolean: Boolean
 }
+`, BuiltIn: false},
+	{Name: "defer.graphql", Input: `directive @defer(if: Boolean = true, label: String) on FRAGMENT_SPREAD | INLINE_FRAGMENT
+directive @stream(if: Boolean = true, label: String, initialCount: Int = 0) on FIELD
+
+extend type Query {
+    deferCase1: DeferModel
+    deferCase2: [DeferModel!]
+}
+
+type DeferModel {
+    id: ID!
+    name: String!
+    values: [String!]! @goField(forceResolver: true)
+}
 `, BuiltIn: false},
 	{Name: "directive.graphql", Input: `directive @length(min: Int!, max: Int, message: String) on ARGUMENT_DEFINITION | INPUT_FIELD_DEFINITION | FIELD_DEFINITION
 directive @range(min: Int = 0, max: Int) on ARGUMENT_DEFINITION
@@ -2407,6 +2717,21 @@ type Slices {
 }
 
 scalar Bytes
+`, BuiltIn: false},
+	{Name: "timeout.graphql", Input: `directive @timeout(ms: Int!) on FIELD_DEFINITION
+
+extend type Query {
+    slowRoot: String @timeout(ms: 50)
+    slowObject: SlowObject
+}
+
+type SlowObject {
+    fast: String!
+    slow: String @timeout(ms: 50)
+    slowRequired: String! @timeout(ms: 50)
+    configured: Int
+    subMillisecond: Int
+}
 `, BuiltIn: false},
 	{Name: "typefallback.graphql", Input: `extend type Query {
     fallback(arg: FallbackToStringEncoding!): FallbackToStringEncoding!
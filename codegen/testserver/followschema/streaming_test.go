@@ -0,0 +1,143 @@
+package followschema
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/stretchr/testify/require"
+	"github.com/valyala/fasthttp"
+)
+
+func streamingResolvers(posts int) *Stub {
+	resolvers := &Stub{}
+	resolvers.QueryResolver.CachedPosts = func(ctx context.Context) ([]*CachedPost, error) {
+		res := make([]*CachedPost, posts)
+		for i := range res {
+			res[i] = &CachedPost{ID: i, Views: i * 10}
+		}
+		return res, nil
+	}
+	resolvers.QueryResolver.TypeHintedPost = func(ctx context.Context) (*CachedPost, error) {
+		return nil, errors.New("no post")
+	}
+	return resolvers
+}
+
+func streamingServer(resolvers *Stub, stream bool) *handler.Server {
+	srv := handler.New(NewExecutableSchema(Config{Resolvers: resolvers}))
+	srv.AddTransport(transport.GET{Stream: stream})
+	srv.AddTransport(transport.POST{Stream: stream, MaxBatchSize: 10})
+	return srv
+}
+
+func TestStreaming(t *testing.T) {
+	resolvers := streamingResolvers(3)
+	query := `{"query":"{ cachedPosts { id views } typeHintedPost { id } }"}`
+
+	do := func(srv *handler.Server, method string) string {
+		var r *http.Request
+		if method == http.MethodGet {
+			r = httptest.NewRequest(method, "/query?query={cachedPosts{id}}", nil)
+		} else {
+			r = httptest.NewRequest(method, "/query", strings.NewReader(query))
+			r.Header.Set("Content-Type", "application/json")
+		}
+		w := httptest.NewRecorder()
+		srv.ServeHTTP(w, r)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		return w.Body.String()
+	}
+
+	buffered := streamingServer(resolvers, false)
+	streamed := streamingServer(resolvers, true)
+	for _, srv := range []*handler.Server{buffered, streamed} {
+		srv.AroundResponses(func(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+			graphql.RegisterExtension(ctx, "streamed", graphql.GetOperationContext(ctx).StreamData)
+			return next(ctx)
+		})
+	}
+
+	t.Run("writes the same response", func(t *testing.T) {
+		require.JSONEq(t, `{
+			"data": {"cachedPosts": [{"id": 0, "views": 0}, {"id": 1, "views": 10}, {"id": 2, "views": 20}], "typeHintedPost": null},
+			"errors": [{"message": "no post", "path": ["typeHintedPost"]}],
+			"extensions": {"streamed": true}
+		}`, do(streamed, http.MethodPost))
+		require.JSONEq(t, strings.Replace(do(buffered, http.MethodPost), `"streamed":false`, `"streamed":true`, 1), do(streamed, http.MethodPost))
+		require.JSONEq(t, `{"data":{"cachedPosts":[{"id":0},{"id":1},{"id":2}]},"extensions":{"streamed":true}}`, do(streamed, http.MethodGet))
+	})
+
+	t.Run("writes the errors after the data", func(t *testing.T) {
+		body := do(streamed, http.MethodPost)
+		require.True(t, strings.HasPrefix(body, `{"data":{"cachedPosts":`), body)
+		require.Less(t, strings.Index(body, `"data"`), strings.Index(body, `"errors"`))
+	})
+
+	t.Run("streams fasthttp responses from a body stream writer", func(t *testing.T) {
+		var req fasthttp.Request
+		req.Header.SetMethod(http.MethodPost)
+		req.SetRequestURI("/query")
+		req.SetBodyString(query)
+		req.Header.SetContentType("application/json")
+		var rctx fasthttp.RequestCtx
+		rctx.Init(&req, nil, nil)
+
+		streamed.ServeFastHTTP(&rctx)
+		require.True(t, rctx.Response.IsBodyStream())
+		require.JSONEq(t, do(streamed, http.MethodPost), string(rctx.Response.Body()))
+
+		rctx.Response.Reset()
+		buffered.ServeFastHTTP(&rctx)
+		require.False(t, rctx.Response.IsBodyStream())
+	})
+
+	t.Run("does not stream batches", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodPost, "/query", strings.NewReader(`[{"query":"{ cachedPosts { id } }"}]`))
+		r.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		streamed.ServeHTTP(w, r)
+		require.JSONEq(t, `[{"data":{"cachedPosts":[{"id":0},{"id":1},{"id":2}]},"extensions":{"streamed":false}}]`, w.Body.String())
+	})
+}
+
+// discardResponseWriter drops the response, so the benchmarks only measure the memory used to write it
+type discardResponseWriter struct {
+	header http.Header
+}
+
+func (w *discardResponseWriter) Header() http.Header         { return w.header }
+func (w *discardResponseWriter) Write(b []byte) (int, error) { return len(b), nil }
+func (w *discardResponseWriter) WriteHeader(statusCode int)  {}
+
+func BenchmarkStreaming(b *testing.B) {
+	resolvers := streamingResolvers(20000)
+	query := `{"query":"{ cachedPosts { id views draft } }"}`
+
+	for _, stream := range []bool{false, true} {
+		name := "buffered"
+		if stream {
+			name = "streamed"
+		}
+		b.Run(name, func(b *testing.B) {
+			srv := streamingServer(resolvers, stream)
+			var body strings.Reader
+			r := httptest.NewRequest(http.MethodPost, "/query", &body)
+			r.Header.Set("Content-Type", "application/json")
+			w := &discardResponseWriter{header: http.Header{}}
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				body.Reset(query)
+				srv.ServeHTTP(w, r)
+			}
+		})
+	}
+}
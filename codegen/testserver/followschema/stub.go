@@ -14,6 +14,13 @@ type Stub struct {
 	BackedByInterfaceResolver struct {
 		ID func(ctx context.Context, obj BackedByInterface) (string, error)
 	}
+	BatchParentResolver struct {
+		Sum  func(ctx context.Context, objs []*BatchParent, add int) ([]int, []error)
+		Name func(ctx context.Context, objs []*BatchParent) ([]*string, []error)
+	}
+	DeferModelResolver struct {
+		Values func(ctx context.Context, obj *DeferModel) ([]string, error)
+	}
 	ErrorsResolver struct {
 		A func(ctx context.Context, obj *Errors) (*Error, error)
 		B func(ctx context.Context, obj *Errors) (*Error, error)
@@ -28,6 +35,7 @@ type Stub struct {
 		ResolverField func(ctx context.Context, obj *ModelMethods) (bool, error)
 	}
 	MutationResolver struct {
+		ViewCachedPost  func(ctx context.Context) (*CachedPost, error)
 		DefaultInput    func(ctx context.Context, input DefaultInput) (*DefaultParametersMirror, error)
 		UpdateSomething func(ctx context.Context, input SpecialInput) (string, error)
 		UpdatePtrToPtr  func(ctx context.Context, input UpdatePtrToPtrOuter) (*PtrToPtrOuter, error)
@@ -61,8 +69,15 @@ type Stub struct {
 		ShapeUnion                       func(ctx context.Context) (ShapeUnion, error)
 		Autobind                         func(ctx context.Context) (*Autobind, error)
 		DeprecatedField                  func(ctx context.Context) (string, error)
+		BatchParents                     func(ctx context.Context) ([]*BatchParent, error)
+		BatchParent                      func(ctx context.Context) (*BatchParent, error)
+		CachedPost                       func(ctx context.Context) (*CachedPost, error)
+		CachedPosts                      func(ctx context.Context) ([]*CachedPost, error)
+		TypeHintedPost                   func(ctx context.Context) (*CachedPost, error)
 		Overlapping                      func(ctx context.Context) (*OverlappingFields, error)
 		DefaultParameters                func(ctx context.Context, falsyBoolean *bool, truthyBoolean *bool) (*DefaultParametersMirror, error)
+		DeferCase1                       func(ctx context.Context) (*DeferModel, error)
+		DeferCase2                       func(ctx context.Context) ([]*DeferModel, error)
 		DirectiveArg                     func(ctx context.Context, arg string) (*string, error)
 		DirectiveNullableArg             func(ctx context.Context, arg *int, arg2 *int, arg3 *string) (*string, error)
 		DirectiveInputNullable           func(ctx context.Context, arg *InputDirectives) (*string, error)
@@ -102,6 +117,8 @@ type Stub struct {
 		DefaultScalar                    func(ctx context.Context, arg string) (string, error)
 		Slices                           func(ctx context.Context) (*Slices, error)
 		ScalarSlice                      func(ctx context.Context) ([]byte, error)
+		SlowRoot                         func(ctx context.Context) (*string, error)
+		SlowObject                       func(ctx context.Context) (*SlowObject, error)
 		Fallback                         func(ctx context.Context, arg FallbackToStringEncoding) (FallbackToStringEncoding, error)
 		OptionalUnion                    func(ctx context.Context) (TestUnion, error)
 		VOkCaseValue                     func(ctx context.Context) (*VOkCaseValue, error)
@@ -112,6 +129,12 @@ type Stub struct {
 		WrappedMap                       func(ctx context.Context) (WrappedMap, error)
 		WrappedSlice                     func(ctx context.Context) (WrappedSlice, error)
 	}
+	SlowObjectResolver struct {
+		Slow           func(ctx context.Context, obj *SlowObject) (*string, error)
+		SlowRequired   func(ctx context.Context, obj *SlowObject) (string, error)
+		Configured     func(ctx context.Context, obj *SlowObject) (*int, error)
+		SubMillisecond func(ctx context.Context, obj *SlowObject) (*int, error)
+	}
 	SubscriptionResolver struct {
 		Updated                func(ctx context.Context) (<-chan string, error)
 		InitPayload            func(ctx context.Context) (<-chan string, error)
@@ -135,6 +158,12 @@ type Stub struct {
 func (r *Stub) BackedByInterface() BackedByInterfaceResolver {
 	return &stubBackedByInterface{r}
 }
+func (r *Stub) BatchParent() BatchParentResolver {
+	return &stubBatchParent{r}
+}
+func (r *Stub) DeferModel() DeferModelResolver {
+	return &stubDeferModel{r}
+}
 func (r *Stub) Errors() ErrorsResolver {
 	return &stubErrors{r}
 }
@@ -162,6 +191,9 @@ func (r *Stub) PrimitiveString() PrimitiveStringResolver {
 func (r *Stub) Query() QueryResolver {
 	return &stubQuery{r}
 }
+func (r *Stub) SlowObject() SlowObjectResolver {
+	return &stubSlowObject{r}
+}
 func (r *Stub) Subscription() SubscriptionResolver {
 	return &stubSubscription{r}
 }
@@ -181,6 +213,21 @@ func (r *stubBackedByInterface) ID(ctx context.Context, obj BackedByInterface) (
 	return r.BackedByInterfaceResolver.ID(ctx, obj)
 }
 
+type stubBatchParent struct{ *Stub }
+
+func (r *stubBatchParent) Sum(ctx context.Context, objs []*BatchParent, add int) ([]int, []error) {
+	return r.BatchParentResolver.Sum(ctx, objs, add)
+}
+func (r *stubBatchParent) Name(ctx context.Context, objs []*BatchParent) ([]*string, []error) {
+	return r.BatchParentResolver.Name(ctx, objs)
+}
+
+type stubDeferModel struct{ *Stub }
+
+func (r *stubDeferModel) Values(ctx context.Context, obj *DeferModel) ([]string, error) {
+	return r.DeferModelResolver.Values(ctx, obj)
+}
+
 type stubErrors struct{ *Stub }
 
 func (r *stubErrors) A(ctx context.Context, obj *Errors) (*Error, error) {
@@ -213,6 +260,9 @@ func (r *stubModelMethods) ResolverField(ctx context.Context, obj *ModelMethods)
 
 type stubMutation struct{ *Stub }
 
+func (r *stubMutation) ViewCachedPost(ctx context.Context) (*CachedPost, error) {
+	return r.MutationResolver.ViewCachedPost(ctx)
+}
 func (r *stubMutation) DefaultInput(ctx context.Context, input DefaultInput) (*DefaultParametersMirror, error) {
 	return r.MutationResolver.DefaultInput(ctx, input)
 }
@@ -297,12 +347,33 @@ func (r *stubQuery) Autobind(ctx context.Context) (*Autobind, error) {
 func (r *stubQuery) DeprecatedField(ctx context.Context) (string, error) {
 	return r.QueryResolver.DeprecatedField(ctx)
 }
+func (r *stubQuery) BatchParents(ctx context.Context) ([]*BatchParent, error) {
+	return r.QueryResolver.BatchParents(ctx)
+}
+func (r *stubQuery) BatchParent(ctx context.Context) (*BatchParent, error) {
+	return r.QueryResolver.BatchParent(ctx)
+}
+func (r *stubQuery) CachedPost(ctx context.Context) (*CachedPost, error) {
+	return r.QueryResolver.CachedPost(ctx)
+}
+func (r *stubQuery) CachedPosts(ctx context.Context) ([]*CachedPost, error) {
+	return r.QueryResolver.CachedPosts(ctx)
+}
+func (r *stubQuery) TypeHintedPost(ctx context.Context) (*CachedPost, error) {
+	return r.QueryResolver.TypeHintedPost(ctx)
+}
 func (r *stubQuery) Overlapping(ctx context.Context) (*OverlappingFields, error) {
 	return r.QueryResolver.Overlapping(ctx)
 }
 func (r *stubQuery) DefaultParameters(ctx context.Context, falsyBoolean *bool, truthyBoolean *bool) (*DefaultParametersMirror, error) {
 	return r.QueryResolver.DefaultParameters(ctx, falsyBoolean, truthyBoolean)
 }
+func (r *stubQuery) DeferCase1(ctx context.Context) (*DeferModel, error) {
+	return r.QueryResolver.DeferCase1(ctx)
+}
+func (r *stubQuery) DeferCase2(ctx context.Context) ([]*DeferModel, error) {
+	return r.QueryResolver.DeferCase2(ctx)
+}
 func (r *stubQuery) DirectiveArg(ctx context.Context, arg string) (*string, error) {
 	return r.QueryResolver.DirectiveArg(ctx, arg)
 }
@@ -420,6 +491,12 @@ func (r *stubQuery) Slices(ctx context.Context) (*Slices, error) {
 func (r *stubQuery) ScalarSlice(ctx context.Context) ([]byte, error) {
 	return r.QueryResolver.ScalarSlice(ctx)
 }
+func (r *stubQuery) SlowRoot(ctx context.Context) (*string, error) {
+	return r.QueryResolver.SlowRoot(ctx)
+}
+func (r *stubQuery) SlowObject(ctx context.Context) (*SlowObject, error) {
+	return r.QueryResolver.SlowObject(ctx)
+}
 func (r *stubQuery) Fallback(ctx context.Context, arg FallbackToStringEncoding) (FallbackToStringEncoding, error) {
 	return r.QueryResolver.Fallback(ctx, arg)
 }
@@ -448,6 +525,21 @@ func (r *stubQuery) WrappedSlice(ctx context.Context) (WrappedSlice, error) {
 	return r.QueryResolver.WrappedSlice(ctx)
 }
 
+type stubSlowObject struct{ *Stub }
+
+func (r *stubSlowObject) Slow(ctx context.Context, obj *SlowObject) (*string, error) {
+	return r.SlowObjectResolver.Slow(ctx, obj)
+}
+func (r *stubSlowObject) SlowRequired(ctx context.Context, obj *SlowObject) (string, error) {
+	return r.SlowObjectResolver.SlowRequired(ctx, obj)
+}
+func (r *stubSlowObject) Configured(ctx context.Context, obj *SlowObject) (*int, error) {
+	return r.SlowObjectResolver.Configured(ctx, obj)
+}
+func (r *stubSlowObject) SubMillisecond(ctx context.Context, obj *SlowObject) (*int, error) {
+	return r.SlowObjectResolver.SubMillisecond(ctx, obj)
+}
+
 type stubSubscription struct{ *Stub }
 
 func (r *stubSubscription) Updated(ctx context.Context) (<-chan string, error) {
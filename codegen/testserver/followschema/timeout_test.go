@@ -0,0 +1,111 @@
+package followschema
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimeout(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+
+	block := func(ctx context.Context) {
+		select {
+		case <-ctx.Done():
+		case <-release:
+		}
+	}
+	ignoreDeadline := func(ctx context.Context) {
+		<-release
+	}
+
+	fast := "fast"
+	resolvers := &Stub{}
+	resolvers.QueryResolver.SlowRoot = func(ctx context.Context) (*string, error) {
+		block(ctx)
+		return &fast, ctx.Err()
+	}
+	resolvers.QueryResolver.SlowObject = func(ctx context.Context) (*SlowObject, error) {
+		return &SlowObject{Fast: fast}, nil
+	}
+	resolvers.SlowObjectResolver.Slow = func(ctx context.Context, obj *SlowObject) (*string, error) {
+		ignoreDeadline(ctx)
+		return &fast, nil
+	}
+	resolvers.SlowObjectResolver.SlowRequired = func(ctx context.Context, obj *SlowObject) (string, error) {
+		return fast, nil
+	}
+	resolvers.SlowObjectResolver.Configured = func(ctx context.Context, obj *SlowObject) (*int, error) {
+		block(ctx)
+		return nil, ctx.Err()
+	}
+
+	resolvers.SlowObjectResolver.SubMillisecond = func(ctx context.Context, obj *SlowObject) (*int, error) {
+		block(ctx)
+		return nil, ctx.Err()
+	}
+
+	c := client.New(handler.NewDefaultServer(NewExecutableSchema(Config{Resolvers: resolvers})))
+
+	t.Run("fails the fields that timed out and returns the rest", func(t *testing.T) {
+		start := time.Now()
+		resp, err := c.RawPost(`{ slowRoot slowObject { fast slow slowRequired configured } }`)
+		require.NoError(t, err)
+		require.Less(t, time.Since(start), time.Second, "the resolver ignoring its deadline is not waited for")
+
+		require.Equal(t, map[string]interface{}{
+			"slowRoot": nil,
+			"slowObject": map[string]interface{}{
+				"fast":         "fast",
+				"slow":         nil,
+				"slowRequired": "fast",
+				"configured":   nil,
+			},
+		}, resp.Data)
+		var errs []struct {
+			Message string
+			Path    []interface{}
+		}
+		require.NoError(t, json.Unmarshal(resp.Errors, &errs))
+		require.Len(t, errs, 3)
+		for _, err := range errs {
+			require.Equal(t, "the field timed out after 50ms", err.Message)
+		}
+		require.ElementsMatch(t, []interface{}{
+			[]interface{}{"slowRoot"},
+			[]interface{}{"slowObject", "slow"},
+			[]interface{}{"slowObject", "configured"},
+		}, resp.Extensions["timeouts"])
+	})
+
+	t.Run("propagates the null of required fields", func(t *testing.T) {
+		resolvers.SlowObjectResolver.SlowRequired = func(ctx context.Context, obj *SlowObject) (string, error) {
+			block(ctx)
+			return "", ctx.Err()
+		}
+
+		resp, err := c.RawPost(`{ slowObject { fast slowRequired } }`)
+		require.NoError(t, err)
+		require.Equal(t, map[string]interface{}{"slowObject": nil}, resp.Data)
+		require.JSONEq(t, `[{"message": "the field timed out after 50ms", "path": ["slowObject", "slowRequired"]}]`, string(resp.Errors))
+	})
+
+	t.Run("keeps timeouts below a millisecond", func(t *testing.T) {
+		resp, err := c.RawPost(`{ slowObject { subMillisecond } }`)
+		require.NoError(t, err)
+		require.JSONEq(t, `[{"message": "the field timed out after 500µs", "path": ["slowObject", "subMillisecond"]}]`, string(resp.Errors))
+	})
+
+	t.Run("fields that return in time are not reported", func(t *testing.T) {
+		resp, err := c.RawPost(`{ slowObject { fast } }`)
+		require.NoError(t, err)
+		require.Nil(t, resp.Errors)
+		require.Nil(t, resp.Extensions["timeouts"])
+	})
+}
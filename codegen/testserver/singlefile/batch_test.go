@@ -0,0 +1,170 @@
+package singlefile
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatch(t *testing.T) {
+	var mu sync.Mutex
+	var calls [][]int
+	record := func(objs []*BatchParent) {
+		ids := make([]int, len(objs))
+		for i, obj := range objs {
+			ids[i] = obj.ID
+		}
+		mu.Lock()
+		calls = append(calls, ids)
+		mu.Unlock()
+	}
+
+	resolvers := &Stub{}
+	resolvers.QueryResolver.BatchParents = func(ctx context.Context) ([]*BatchParent, error) {
+		return []*BatchParent{{ID: 1}, nil, {ID: 2}, {ID: 3}}, nil
+	}
+	resolvers.QueryResolver.BatchParent = func(ctx context.Context) (*BatchParent, error) {
+		return &BatchParent{ID: 4}, nil
+	}
+	resolvers.BatchParentResolver.Sum = func(ctx context.Context, objs []*BatchParent, add int) ([]int, []error) {
+		record(objs)
+		res := make([]int, len(objs))
+		for i, obj := range objs {
+			res[i] = obj.ID + add
+		}
+		return res, nil
+	}
+	resolvers.BatchParentResolver.Name = func(ctx context.Context, objs []*BatchParent) ([]*string, []error) {
+		record(objs)
+		res := make([]*string, len(objs))
+		errs := make([]error, len(objs))
+		for i, obj := range objs {
+			if obj.ID == 2 {
+				errs[i] = fmt.Errorf("no name for %d", obj.ID)
+				continue
+			}
+			name := fmt.Sprintf("parent %d", obj.ID)
+			res[i] = &name
+		}
+		return res, errs
+	}
+
+	c := client.New(handler.NewDefaultServer(NewExecutableSchema(Config{Resolvers: resolvers})))
+
+	t.Run("resolves the objects of a list at once", func(t *testing.T) {
+		calls = nil
+		var resp struct {
+			BatchParents []*struct{ Sum int }
+		}
+		c.MustPost(`query { batchParents { sum(add: 10) } }`, &resp)
+
+		require.Equal(t, 11, resp.BatchParents[0].Sum)
+		require.Nil(t, resp.BatchParents[1])
+		require.Equal(t, 12, resp.BatchParents[2].Sum)
+		require.Equal(t, 13, resp.BatchParents[3].Sum)
+		require.Equal(t, [][]int{{1, 2, 3}}, calls)
+	})
+
+	t.Run("calls the batch method once per alias", func(t *testing.T) {
+		calls = nil
+		var resp struct {
+			BatchParents []*struct{ A, B int }
+		}
+		c.MustPost(`query { batchParents { a: sum b: sum(add: 1) } }`, &resp)
+
+		require.Equal(t, 3, resp.BatchParents[3].A)
+		require.Equal(t, 4, resp.BatchParents[3].B)
+		require.Equal(t, [][]int{{1, 2, 3}, {1, 2, 3}}, calls)
+	})
+
+	t.Run("reports the errors of each object", func(t *testing.T) {
+		calls = nil
+		resp, err := c.RawPost(`query { batchParents { name } }`)
+		require.NoError(t, err)
+
+		require.JSONEq(t, `[{"message": "no name for 2", "path": ["batchParents", 2, "name"]}]`, string(resp.Errors))
+		require.Equal(t, []interface{}{
+			map[string]interface{}{"name": "parent 1"},
+			nil,
+			map[string]interface{}{"name": nil},
+			map[string]interface{}{"name": "parent 3"},
+		}, resp.Data.(map[string]interface{})["batchParents"])
+		require.Equal(t, [][]int{{1, 2, 3}}, calls)
+	})
+
+	t.Run("resolves objects outside of lists on their own", func(t *testing.T) {
+		calls = nil
+		var resp struct {
+			BatchParent struct{ Sum int }
+		}
+		c.MustPost(`query { batchParent { sum } }`, &resp)
+
+		require.Equal(t, 4, resp.BatchParent.Sum)
+		require.Equal(t, [][]int{{4}}, calls)
+	})
+
+	t.Run("passes the field context of the batched field", func(t *testing.T) {
+		sum := resolvers.BatchParentResolver.Sum
+		defer func() { resolvers.BatchParentResolver.Sum = sum }()
+
+		var fc *graphql.FieldContext
+		resolvers.BatchParentResolver.Sum = func(ctx context.Context, objs []*BatchParent, add int) ([]int, []error) {
+			fc = graphql.GetFieldContext(ctx)
+			return sum(ctx, objs, add)
+		}
+		var resp struct {
+			BatchParents []*struct{ Total int }
+		}
+		c.MustPost(`query { batchParents { total: sum(add: 10) } }`, &resp)
+
+		require.Equal(t, "BatchParent", fc.Object)
+		require.Equal(t, "total", fc.Field.Alias)
+		require.Equal(t, map[string]interface{}{"add": 10}, fc.Args)
+		require.Equal(t, "batchParents", fc.Parent.Field.Name)
+	})
+
+	t.Run("resolves objects on their own after the batch method panics", func(t *testing.T) {
+		name := resolvers.BatchParentResolver.Name
+		defer func() { resolvers.BatchParentResolver.Name = name }()
+
+		calls = nil
+		resolvers.BatchParentResolver.Name = func(ctx context.Context, objs []*BatchParent) ([]*string, []error) {
+			if len(objs) > 1 || objs[0].ID == 3 {
+				record(objs)
+				panic("boom")
+			}
+			return name(ctx, objs)
+		}
+		resp, err := c.RawPost(`query { batchParents { id name } }`)
+		require.NoError(t, err)
+
+		require.JSONEq(t, `[
+			{"message": "internal system error", "path": ["batchParents", 3, "name"]},
+			{"message": "no name for 2", "path": ["batchParents", 2, "name"]}
+		]`, string(resp.Errors))
+		require.Equal(t, []interface{}{
+			map[string]interface{}{"id": float64(1), "name": "parent 1"},
+			nil,
+			map[string]interface{}{"id": float64(2), "name": nil},
+			map[string]interface{}{"id": float64(3), "name": nil},
+		}, resp.Data.(map[string]interface{})["batchParents"])
+		require.ElementsMatch(t, [][]int{{1, 2, 3}, {1}, {2}, {3}}, calls)
+	})
+
+	t.Run("checks the number of results", func(t *testing.T) {
+		resolvers.BatchParentResolver.Sum = func(ctx context.Context, objs []*BatchParent, add int) ([]int, []error) {
+			return []int{1}, nil
+		}
+		resp, err := c.RawPost(`query { batchParents { id sum } }`)
+		require.NoError(t, err)
+
+		require.Contains(t, string(resp.Errors), "the batch method of BatchParent.sum returned 1 results for 3 objects")
+		require.Contains(t, string(resp.Errors), `"path":["batchParents",0,"sum"]`)
+	})
+}
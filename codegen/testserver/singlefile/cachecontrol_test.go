@@ -0,0 +1,85 @@
+package singlefile
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCacheControl(t *testing.T) {
+	post := &CachedPost{ID: 1, Author: &CachedAuthor{Name: "Ada"}, Editor: &CachedAuthor{Name: "Bob"}}
+	resolvers := &Stub{}
+	resolvers.QueryResolver.CachedPost = func(ctx context.Context) (*CachedPost, error) {
+		return post, nil
+	}
+	resolvers.QueryResolver.CachedPosts = func(ctx context.Context) ([]*CachedPost, error) {
+		return []*CachedPost{post}, nil
+	}
+	resolvers.QueryResolver.TypeHintedPost = func(ctx context.Context) (*CachedPost, error) {
+		return post, nil
+	}
+	resolvers.QueryResolver.Valid = func(ctx context.Context) (string, error) {
+		graphql.GetCachePolicy(ctx).RestrictMaxAge(10)
+		return "Ok", nil
+	}
+
+	srv := handler.NewDefaultServer(NewExecutableSchema(Config{Resolvers: resolvers}))
+	srv.Use(&extension.CacheControl{})
+
+	cacheControl := func(t *testing.T, query string) string {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodPost, "/query", strings.NewReader(`{"query":"`+query+`"}`))
+		r.Header.Set("Content-Type", "application/json")
+		srv.ServeHTTP(w, r)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		return w.Header().Get("Cache-Control")
+	}
+
+	t.Run("the hint of a field overrides the one of its type", func(t *testing.T) {
+		require.Equal(t, "max-age=120, public", cacheControl(t, `{ cachedPost { id } }`))
+		require.Equal(t, "max-age=30, public", cacheControl(t, `{ typeHintedPost { id } }`))
+	})
+
+	t.Run("takes the lowest max age", func(t *testing.T) {
+		require.Equal(t, "max-age=60, public", cacheControl(t, `{ cachedPost { id } cachedPosts { id } }`))
+		require.Equal(t, "max-age=5, public", cacheControl(t, `{ cachedPosts { views } }`))
+	})
+
+	t.Run("private fields make the response private", func(t *testing.T) {
+		require.Equal(t, "max-age=120, private", cacheControl(t, `{ cachedPost { draft } }`))
+	})
+
+	t.Run("composite fields without hints can't be cached", func(t *testing.T) {
+		require.Equal(t, "max-age=120, public", cacheControl(t, `{ cachedPost { author { name } } }`))
+		require.Equal(t, "", cacheControl(t, `{ cachedPost { editor { name } } }`))
+	})
+
+	t.Run("root fields without hints can't be cached", func(t *testing.T) {
+		require.Equal(t, "", cacheControl(t, `{ cachedPost { id } deprecatedField }`))
+	})
+
+	t.Run("resolvers restrict the policy", func(t *testing.T) {
+		srv := handler.NewDefaultServer(NewExecutableSchema(Config{Resolvers: resolvers}))
+		srv.Use(&extension.CacheControl{DefaultMaxAge: 60})
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/query?query="+url.QueryEscape(`{ valid cachedPost { id } }`), nil)
+		srv.ServeHTTP(w, r)
+		require.Equal(t, "max-age=10, public", w.Header().Get("Cache-Control"))
+	})
+
+	t.Run("responses with errors are not cached", func(t *testing.T) {
+		resolvers.QueryResolver.TypeHintedPost = func(ctx context.Context) (*CachedPost, error) {
+			return nil, context.Canceled
+		}
+		require.Equal(t, "", cacheControl(t, `{ cachedPost { id } typeHintedPost { id } }`))
+	})
+}
@@ -41,6 +41,24 @@ type B struct {
 
 func (B) IsTestUnion() {}
 
+type BatchParent struct {
+	ID   int     `json:"id"`
+	Sum  int     `json:"sum"`
+	Name *string `json:"name"`
+}
+
+type CachedAuthor struct {
+	Name string `json:"name"`
+}
+
+type CachedPost struct {
+	ID     int           `json:"id"`
+	Views  int           `json:"views"`
+	Draft  *string       `json:"draft"`
+	Author *CachedAuthor `json:"author"`
+	Editor *CachedAuthor `json:"editor"`
+}
+
 type Cat struct {
 	Species  string `json:"species"`
 	CatBreed string `json:"catBreed"`
@@ -79,6 +97,12 @@ type DefaultParametersMirror struct {
 	TruthyBoolean *bool `json:"truthyBoolean"`
 }
 
+type DeferModel struct {
+	ID     string   `json:"id"`
+	Name   string   `json:"name"`
+	Values []string `json:"values"`
+}
+
 type Dog struct {
 	Species  string `json:"species"`
 	DogBreed string `json:"dogBreed"`
@@ -157,6 +181,14 @@ type Slices struct {
 	Test4 []string  `json:"test4"`
 }
 
+type SlowObject struct {
+	Fast           string  `json:"fast"`
+	Slow           *string `json:"slow"`
+	SlowRequired   string  `json:"slowRequired"`
+	Configured     *int    `json:"configured"`
+	SubMillisecond *int    `json:"subMillisecond"`
+}
+
 type SpecialInput struct {
 	Nesting *NestedInput `json:"nesting"`
 }
@@ -221,6 +253,47 @@ type IIt struct {
 	ID string `json:"id"`
 }
 
+type CacheControlScope string
+
+const (
+	CacheControlScopePublic  CacheControlScope = "PUBLIC"
+	CacheControlScopePrivate CacheControlScope = "PRIVATE"
+)
+
+var AllCacheControlScope = []CacheControlScope{
+	CacheControlScopePublic,
+	CacheControlScopePrivate,
+}
+
+func (e CacheControlScope) IsValid() bool {
+	switch e {
+	case CacheControlScopePublic, CacheControlScopePrivate:
+		return true
+	}
+	return false
+}
+
+func (e CacheControlScope) String() string {
+	return string(e)
+}
+
+func (e *CacheControlScope) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CacheControlScope(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CacheControlScope", str)
+	}
+	return nil
+}
+
+func (e CacheControlScope) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type EnumTest string
 
 const (
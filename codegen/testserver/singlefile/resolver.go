@@ -16,6 +16,18 @@ func (r *backedByInterfaceResolver) ID(ctx context.Context, obj BackedByInterfac
 	panic("not implemented")
 }
 
+func (r *batchParentResolver) Sum(ctx context.Context, objs []*BatchParent, add int) ([]int, []error) {
+	panic("not implemented")
+}
+
+func (r *batchParentResolver) Name(ctx context.Context, objs []*BatchParent) ([]*string, []error) {
+	panic("not implemented")
+}
+
+func (r *deferModelResolver) Values(ctx context.Context, obj *DeferModel) ([]string, error) {
+	panic("not implemented")
+}
+
 func (r *errorsResolver) A(ctx context.Context, obj *Errors) (*Error, error) {
 	panic("not implemented")
 }
@@ -44,6 +56,10 @@ func (r *modelMethodsResolver) ResolverField(ctx context.Context, obj *ModelMeth
 	panic("not implemented")
 }
 
+func (r *mutationResolver) ViewCachedPost(ctx context.Context) (*CachedPost, error) {
+	panic("not implemented")
+}
+
 func (r *mutationResolver) DefaultInput(ctx context.Context, input DefaultInput) (*DefaultParametersMirror, error) {
 	panic("not implemented")
 }
@@ -136,6 +152,26 @@ func (r *queryResolver) DeprecatedField(ctx context.Context) (string, error) {
 	panic("not implemented")
 }
 
+func (r *queryResolver) BatchParents(ctx context.Context) ([]*BatchParent, error) {
+	panic("not implemented")
+}
+
+func (r *queryResolver) BatchParent(ctx context.Context) (*BatchParent, error) {
+	panic("not implemented")
+}
+
+func (r *queryResolver) CachedPost(ctx context.Context) (*CachedPost, error) {
+	panic("not implemented")
+}
+
+func (r *queryResolver) CachedPosts(ctx context.Context) ([]*CachedPost, error) {
+	panic("not implemented")
+}
+
+func (r *queryResolver) TypeHintedPost(ctx context.Context) (*CachedPost, error) {
+	panic("not implemented")
+}
+
 func (r *queryResolver) Overlapping(ctx context.Context) (*OverlappingFields, error) {
 	panic("not implemented")
 }
@@ -144,6 +180,14 @@ func (r *queryResolver) DefaultParameters(ctx context.Context, falsyBoolean *boo
 	panic("not implemented")
 }
 
+func (r *queryResolver) DeferCase1(ctx context.Context) (*DeferModel, error) {
+	panic("not implemented")
+}
+
+func (r *queryResolver) DeferCase2(ctx context.Context) ([]*DeferModel, error) {
+	panic("not implemented")
+}
+
 func (r *queryResolver) DirectiveArg(ctx context.Context, arg string) (*string, error) {
 	panic("not implemented")
 }
@@ -300,6 +344,14 @@ func (r *queryResolver) ScalarSlice(ctx context.Context) ([]byte, error) {
 	panic("not implemented")
 }
 
+func (r *queryResolver) SlowRoot(ctx context.Context) (*string, error) {
+	panic("not implemented")
+}
+
+func (r *queryResolver) SlowObject(ctx context.Context) (*SlowObject, error) {
+	panic("not implemented")
+}
+
 func (r *queryResolver) Fallback(ctx context.Context, arg FallbackToStringEncoding) (FallbackToStringEncoding, error) {
 	panic("not implemented")
 }
@@ -336,6 +388,22 @@ func (r *queryResolver) WrappedSlice(ctx context.Context) (WrappedSlice, error)
 	panic("not implemented")
 }
 
+func (r *slowObjectResolver) Slow(ctx context.Context, obj *SlowObject) (*string, error) {
+	panic("not implemented")
+}
+
+func (r *slowObjectResolver) SlowRequired(ctx context.Context, obj *SlowObject) (string, error) {
+	panic("not implemented")
+}
+
+func (r *slowObjectResolver) Configured(ctx context.Context, obj *SlowObject) (*int, error) {
+	panic("not implemented")
+}
+
+func (r *slowObjectResolver) SubMillisecond(ctx context.Context, obj *SlowObject) (*int, error) {
+	panic("not implemented")
+}
+
 func (r *subscriptionResolver) Updated(ctx context.Context) (<-chan string, error) {
 	panic("not implemented")
 }
@@ -381,6 +449,12 @@ func (r *Resolver) BackedByInterface() BackedByInterfaceResolver {
 	return &backedByInterfaceResolver{r}
 }
 
+// BatchParent returns BatchParentResolver implementation.
+func (r *Resolver) BatchParent() BatchParentResolver { return &batchParentResolver{r} }
+
+// DeferModel returns DeferModelResolver implementation.
+func (r *Resolver) DeferModel() DeferModelResolver { return &deferModelResolver{r} }
+
 // Errors returns ErrorsResolver implementation.
 func (r *Resolver) Errors() ErrorsResolver { return &errorsResolver{r} }
 
@@ -410,6 +484,9 @@ func (r *Resolver) PrimitiveString() PrimitiveStringResolver { return &primitive
 // Query returns QueryResolver implementation.
 func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
 
+// SlowObject returns SlowObjectResolver implementation.
+func (r *Resolver) SlowObject() SlowObjectResolver { return &slowObjectResolver{r} }
+
 // Subscription returns SubscriptionResolver implementation.
 func (r *Resolver) Subscription() SubscriptionResolver { return &subscriptionResolver{r} }
 
@@ -423,6 +500,8 @@ func (r *Resolver) WrappedMap() WrappedMapResolver { return &wrappedMapResolver{
 func (r *Resolver) WrappedSlice() WrappedSliceResolver { return &wrappedSliceResolver{r} }
 
 type backedByInterfaceResolver struct{ *Resolver }
+type batchParentResolver struct{ *Resolver }
+type deferModelResolver struct{ *Resolver }
 type errorsResolver struct{ *Resolver }
 type forcedResolverResolver struct{ *Resolver }
 type modelMethodsResolver struct{ *Resolver }
@@ -432,6 +511,7 @@ type panicsResolver struct{ *Resolver }
 type primitiveResolver struct{ *Resolver }
 type primitiveStringResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
+type slowObjectResolver struct{ *Resolver }
 type subscriptionResolver struct{ *Resolver }
 type userResolver struct{ *Resolver }
 type wrappedMapResolver struct{ *Resolver }
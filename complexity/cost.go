@@ -0,0 +1,411 @@
+package complexity
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// Cost is the static cost of an operation, estimated from the @cost and @listSize directives of the schema.
+type Cost struct {
+	// Total is the cost of the whole operation
+	Total int
+
+	// Fields is the cost of every field selected by the operation, in the order they appear in the document.
+	// Fields selected through fragments are listed every time the fragment is spread.
+	Fields []FieldCost
+}
+
+// FieldCost is the cost of a single field selection, including the cost of its arguments and of every field
+// selected below it.
+type FieldCost struct {
+	// Path to the field in the response, without list indexes
+	Path ast.Path
+
+	// Coordinate of the field definition in the schema, eg Query.users
+	Coordinate string
+
+	// ListSize is the number of items the field is expected to return, 1 if it is not a list
+	ListSize int
+
+	Cost int
+}
+
+// defaultSlicingArguments are used to size lists that have no @listSize directive
+var defaultSlicingArguments = []string{"first", "last"}
+
+// CalculateCost estimates the cost of an operation following the GraphQL cost directives specification:
+//
+//   - every field returning an object, interface or union weighs 1, and every scalar or enum field weighs 0.
+//     @cost on the field definition, or else on the returned type, overrides its weight.
+//   - arguments and input fields marked with @cost add their weight when they are provided.
+//   - list fields multiply the weight of the field and the cost of their selections by the size of the list, which
+//     is read from the slicing arguments of @listSize, or its assumedSize. Lists without @listSize are sized by their
+//     first or last argument, and are assumed to hold a single item otherwise.
+//   - when @listSize has sizedFields, the size applies to those fields of the returned type rather than to the
+//     field itself, as with connections.
+func CalculateCost(es graphql.ExecutableSchema, op *ast.OperationDefinition, vars map[string]interface{}) *Cost {
+	walker := costWalker{
+		schema: es.Schema(),
+		vars:   vars,
+		cost:   &Cost{},
+	}
+	walker.cost.Total = walker.selectionSetCost(nil, op.SelectionSet, nil)
+	return walker.cost
+}
+
+type costWalker struct {
+	schema *ast.Schema
+	vars   map[string]interface{}
+	cost   *Cost
+}
+
+// selectionSetCost sums the cost of every field in selectionSet. sizedFields holds the list sizes a parent
+// @listSize directive assigned to some of the fields.
+func (cw costWalker) selectionSetCost(path ast.Path, selectionSet ast.SelectionSet, sizedFields map[string]int) int {
+	var cost int
+	for _, selection := range selectionSet {
+		switch s := selection.(type) {
+		case *ast.Field:
+			cost = safeAdd(cost, cw.fieldCost(path, s, sizedFields))
+
+		case *ast.FragmentSpread:
+			cost = safeAdd(cost, cw.selectionSetCost(path, s.Definition.SelectionSet, sizedFields))
+
+		case *ast.InlineFragment:
+			cost = safeAdd(cost, cw.selectionSetCost(path, s.SelectionSet, sizedFields))
+		}
+	}
+	return cost
+}
+
+func (cw costWalker) fieldCost(path ast.Path, field *ast.Field, sizedFields map[string]int) int {
+	if strings.HasPrefix(field.Name, "__") {
+		return 0
+	}
+
+	fieldPath := append(append(ast.Path{}, path...), ast.PathName(field.Alias))
+	index := len(cw.cost.Fields)
+	cw.cost.Fields = append(cw.cost.Fields, FieldCost{
+		Path:       fieldPath,
+		Coordinate: field.ObjectDefinition.Name + "." + field.Name,
+	})
+
+	weight := FieldWeight(cw.schema, field.Definition)
+	args := field.ArgumentMap(cw.vars)
+	listSize := 1
+	var childSizedFields map[string]int
+	if size, ok := sizedFields[field.Name]; ok {
+		listSize = size
+	} else if size, sized := cw.listSize(field.Definition, args); len(sized) > 0 {
+		childSizedFields = make(map[string]int, len(sized))
+		for _, name := range sized {
+			childSizedFields[name] = size
+		}
+	} else if field.Definition.Type.Elem != nil {
+		listSize = size
+	}
+
+	childCost := 0
+	if len(field.SelectionSet) > 0 {
+		childCost = cw.selectionSetCost(fieldPath, field.SelectionSet, childSizedFields)
+	}
+
+	cost := safeAdd(cw.argumentsCost(field, args), safeMultiply(listSize, safeAdd(weight, childCost)))
+	cw.cost.Fields[index].ListSize = listSize
+	cw.cost.Fields[index].Cost = cost
+	return cost
+}
+
+// FieldWeight returns the weight of every item returned by a field, see CalculateCost.
+func FieldWeight(schema *ast.Schema, field *ast.FieldDefinition) int {
+	weight := 0
+	def := schema.Types[field.Type.Name()]
+	if def != nil {
+		switch def.Kind {
+		case ast.Object, ast.Interface, ast.Union:
+			weight = 1
+		}
+		if w, ok := costWeight(def.Directives); ok {
+			weight = w
+		}
+	}
+	if w, ok := costWeight(field.Directives); ok {
+		weight = w
+	}
+	return weight
+}
+
+// ArgumentsCost returns the weight of the arguments and input fields provided to a field, see CalculateCost.
+func ArgumentsCost(schema *ast.Schema, field *ast.Field, vars map[string]interface{}) int {
+	walker := costWalker{
+		schema: schema,
+		vars:   vars,
+	}
+	return walker.argumentsCost(field, field.ArgumentMap(vars))
+}
+
+// listSize returns the expected size of the list returned by a field, and the fields of the returned type the size
+// applies to if it is not the field itself.
+func (cw costWalker) listSize(def *ast.FieldDefinition, args map[string]interface{}) (int, []string) {
+	slicingArguments := defaultSlicingArguments
+	assumedSize := -1
+	var sizedFields []string
+
+	if d := def.Directives.ForName("listSize"); d != nil {
+		slicingArguments = stringList(d.Arguments.ForName("slicingArguments"))
+		sizedFields = stringList(d.Arguments.ForName("sizedFields"))
+		if arg := d.Arguments.ForName("assumedSize"); arg != nil && arg.Value != nil {
+			if size, err := strconv.Atoi(arg.Value.Raw); err == nil {
+				assumedSize = size
+			}
+		}
+	}
+
+	size := -1
+	for _, name := range slicingArguments {
+		if value, ok := toInt(argumentValue(args, name)); ok && value > size {
+			size = value
+		}
+	}
+	if size < 0 {
+		size = assumedSize
+	}
+	if size < 0 {
+		size = 1
+	}
+	return size, sizedFields
+}
+
+// argumentsCost sums the weight of the arguments and input fields provided to a field.
+func (cw costWalker) argumentsCost(field *ast.Field, args map[string]interface{}) int {
+	var cost int
+	for _, arg := range field.Definition.Arguments {
+		if field.Arguments.ForName(arg.Name) == nil {
+			continue
+		}
+		if w, ok := costWeight(arg.Directives); ok {
+			cost = safeAdd(cost, w)
+		}
+		cost = safeAdd(cost, cw.inputCost(arg.Type, args[arg.Name]))
+	}
+	return cost
+}
+
+func (cw costWalker) inputCost(typ *ast.Type, value interface{}) int {
+	var cost int
+	if typ.Elem != nil {
+		values, _ := value.([]interface{})
+		for _, v := range values {
+			cost = safeAdd(cost, cw.inputCost(typ.Elem, v))
+		}
+		return cost
+	}
+
+	fields, ok := value.(map[string]interface{})
+	def := cw.schema.Types[typ.NamedType]
+	if !ok || def == nil || def.Kind != ast.InputObject {
+		return 0
+	}
+	for _, field := range def.Fields {
+		v, provided := fields[field.Name]
+		if !provided {
+			continue
+		}
+		if w, ok := costWeight(field.Directives); ok {
+			cost = safeAdd(cost, w)
+		}
+		cost = safeAdd(cost, cw.inputCost(field.Type, v))
+	}
+	return cost
+}
+
+// ValidateCostDirectives checks that every @cost and @listSize directive in the schema can be used to calculate
+// costs. It is run by gqlgen when generating code. Directives named @cost or @listSize that do not have the
+// arguments of the specification are not checked.
+func ValidateCostDirectives(schema *ast.Schema) error {
+	if !isCostDirective(schema, "cost", "weight") && !isCostDirective(schema, "listSize", "slicingArguments") {
+		return nil
+	}
+
+	for _, def := range schema.Types {
+		if err := validateCost(def.Name, def.Directives); err != nil {
+			return err
+		}
+
+		for _, field := range def.Fields {
+			coordinate := def.Name + "." + field.Name
+			if err := validateCost(coordinate, field.Directives); err != nil {
+				return err
+			}
+			for _, arg := range field.Arguments {
+				if err := validateCost(coordinate+"("+arg.Name+":)", arg.Directives); err != nil {
+					return err
+				}
+			}
+			if err := validateListSize(schema, coordinate, field); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func isCostDirective(schema *ast.Schema, name string, arg string) bool {
+	def := schema.Directives[name]
+	return def != nil && def.Arguments.ForName(arg) != nil
+}
+
+func validateCost(coordinate string, directives ast.DirectiveList) error {
+	d := directives.ForName("cost")
+	if d == nil || d.Arguments.ForName("weight") == nil {
+		return nil
+	}
+
+	arg := d.Arguments.ForName("weight")
+	weight, err := strconv.ParseFloat(arg.Value.Raw, 64)
+	if err != nil || weight < 0 {
+		return fmt.Errorf("%s: @cost weight %q is not a positive number", coordinate, arg.Value.Raw)
+	}
+	return nil
+}
+
+func validateListSize(schema *ast.Schema, coordinate string, field *ast.FieldDefinition) error {
+	d := field.Directives.ForName("listSize")
+	if d == nil || !isCostDirective(schema, "listSize", "slicingArguments") {
+		return nil
+	}
+
+	sizedFields := stringList(d.Arguments.ForName("sizedFields"))
+	if field.Type.Elem == nil && len(sizedFields) == 0 {
+		return fmt.Errorf("%s: @listSize can only be used on lists, or with sizedFields", coordinate)
+	}
+
+	if arg := d.Arguments.ForName("assumedSize"); arg != nil && arg.Value != nil && arg.Value.Kind != ast.NullValue {
+		if size, err := strconv.Atoi(arg.Value.Raw); err != nil || size < 0 {
+			return fmt.Errorf("%s: @listSize assumedSize %q is not a positive integer", coordinate, arg.Value.Raw)
+		}
+	}
+
+	for _, name := range stringList(d.Arguments.ForName("slicingArguments")) {
+		typ := argumentType(schema, field.Arguments, name)
+		if typ == nil {
+			return fmt.Errorf("%s: @listSize slicing argument %s is not an argument of the field", coordinate, name)
+		}
+		if typ.Name() != "Int" || typ.Elem != nil {
+			return fmt.Errorf("%s: @listSize slicing argument %s must be an Int", coordinate, name)
+		}
+	}
+
+	for _, name := range sizedFields {
+		def := schema.Types[field.Type.Name()]
+		sized := def.Fields.ForName(name)
+		if sized == nil {
+			return fmt.Errorf("%s: @listSize sized field %s is not a field of %s", coordinate, name, def.Name)
+		}
+		if sized.Type.Elem == nil {
+			return fmt.Errorf("%s: @listSize sized field %s is not a list", coordinate, name)
+		}
+	}
+	return nil
+}
+
+// argumentType returns the type of an argument, or of an input field when name is a path like input.first
+func argumentType(schema *ast.Schema, args ast.ArgumentDefinitionList, name string) *ast.Type {
+	parts := strings.Split(name, ".")
+	arg := args.ForName(parts[0])
+	if arg == nil {
+		return nil
+	}
+
+	typ := arg.Type
+	for _, part := range parts[1:] {
+		def := schema.Types[typ.Name()]
+		if def == nil || def.Kind != ast.InputObject || typ.Elem != nil {
+			return nil
+		}
+		field := def.Fields.ForName(part)
+		if field == nil {
+			return nil
+		}
+		typ = field.Type
+	}
+	return typ
+}
+
+// argumentValue returns the value of an argument, or of an input field when name is a path like input.first
+func argumentValue(args map[string]interface{}, name string) interface{} {
+	var value interface{} = args
+	for _, part := range strings.Split(name, ".") {
+		fields, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		value = fields[part]
+	}
+	return value
+}
+
+// costWeight returns the weight set by a @cost directive, rounded up to an integer.
+func costWeight(directives ast.DirectiveList) (int, bool) {
+	d := directives.ForName("cost")
+	if d == nil {
+		return 0, false
+	}
+	arg := d.Arguments.ForName("weight")
+	if arg == nil || arg.Value == nil {
+		return 0, false
+	}
+	weight, err := strconv.ParseFloat(arg.Value.Raw, 64)
+	if err != nil || weight < 0 {
+		return 0, false
+	}
+	if weight >= float64(maxInt) {
+		return maxInt, true
+	}
+	return int(math.Ceil(weight)), true
+}
+
+func stringList(arg *ast.Argument) []string {
+	if arg == nil || arg.Value == nil {
+		return nil
+	}
+	var list []string
+	for _, child := range arg.Value.Children {
+		list = append(list, child.Value.Raw)
+	}
+	return list
+}
+
+func toInt(value interface{}) (int, bool) {
+	switch v := value.(type) {
+	case int:
+		return v, true
+	case int64:
+		return int(v), true
+	case int32:
+		return int(v), true
+	case float64:
+		return int(v), true
+	case json.Number:
+		i, err := v.Int64()
+		return int(i), err == nil
+	}
+	return 0, false
+}
+
+// safeMultiply is a saturating multiplication of a and b, see safeAdd.
+func safeMultiply(a, b int) int {
+	if a <= 0 || b <= 0 {
+		return 0
+	}
+	if a > maxInt/b {
+		return maxInt
+	}
+	return a * b
+}
@@ -0,0 +1,173 @@
+package complexity
+
+import (
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+const costDirectives = `
+directive @cost(weight: String!) on ARGUMENT_DEFINITION | ENUM | FIELD_DEFINITION | INPUT_FIELD_DEFINITION | OBJECT | SCALAR
+directive @listSize(assumedSize: Int, slicingArguments: [String!], sizedFields: [String!], requireOneSlicingArgument: Boolean = true) on FIELD_DEFINITION
+`
+
+var costSchema = gqlparser.MustLoadSchema(
+	&ast.Source{
+		Name: "cost.graphql",
+		Input: costDirectives + `
+		type User {
+			name: String
+			avatar: String @cost(weight: "2.5")
+			friends(first: Int, last: Int): [User]
+			posts: [Post] @listSize(assumedSize: 5)
+		}
+
+		type Post @cost(weight: "3") {
+			title: String
+		}
+
+		type UserConnection {
+			edges: [UserEdge]
+			nodes: [User]
+			totalCount: Int
+		}
+
+		type UserEdge {
+			node: User
+		}
+
+		input UserFilter {
+			name: String
+			search: String @cost(weight: "10")
+		}
+
+		type Query {
+			user: User
+			users(filter: UserFilter, expensive: Boolean @cost(weight: "4")): [User]
+			search(input: SearchInput, limit: Int): [User] @listSize(slicingArguments: ["input.first", "limit"], assumedSize: 50)
+			connection(first: Int): UserConnection @listSize(slicingArguments: ["first"], sizedFields: ["edges", "nodes"])
+			expensive: String @cost(weight: "100")
+		}
+
+		input SearchInput {
+			first: Int
+		}
+		`,
+	},
+)
+
+func calculateCost(t *testing.T, source string, vars map[string]interface{}) *Cost {
+	t.Helper()
+	query := gqlparser.MustLoadQuery(costSchema, source)
+
+	es := &graphql.ExecutableSchemaMock{
+		SchemaFunc: func() *ast.Schema {
+			return costSchema
+		},
+	}
+	return CalculateCost(es, query.Operations[0], vars)
+}
+
+func TestCalculateCost(t *testing.T) {
+	t.Run("objects weigh one and scalars nothing", func(t *testing.T) {
+		cost := calculateCost(t, `{ user { name } }`, nil)
+		require.Equal(t, 1, cost.Total)
+	})
+
+	t.Run("uses @cost on fields and types", func(t *testing.T) {
+		cost := calculateCost(t, `{ expensive user { avatar posts { title } } }`, nil)
+		// 100 + user (1 + avatar 3 + posts 5 * 3)
+		require.Equal(t, 119, cost.Total)
+	})
+
+	t.Run("multiplies lists by their first or last argument", func(t *testing.T) {
+		cost := calculateCost(t, `query($n: Int) { user { friends(first: 10) { friends(last: $n) { name } } } }`, map[string]interface{}{"n": 3})
+		// user (1 + friends 10 * (1 + friends 3 * 1))
+		require.Equal(t, 41, cost.Total)
+	})
+
+	t.Run("lists without a size hold one item", func(t *testing.T) {
+		cost := calculateCost(t, `{ user { friends { name } } }`, nil)
+		require.Equal(t, 2, cost.Total)
+	})
+
+	t.Run("uses @listSize slicing arguments", func(t *testing.T) {
+		cost := calculateCost(t, `{ search(input: { first: 20 }, limit: 30) { name } }`, nil)
+		require.Equal(t, 30, cost.Total)
+
+		cost = calculateCost(t, `{ search(input: { first: 20 }) { name } }`, nil)
+		require.Equal(t, 20, cost.Total)
+
+		cost = calculateCost(t, `{ search { name } }`, nil)
+		require.Equal(t, 50, cost.Total)
+	})
+
+	t.Run("uses @listSize sized fields", func(t *testing.T) {
+		cost := calculateCost(t, `{ connection(first: 10) { totalCount edges { node { name } } nodes { name } } }`, nil)
+		// connection (1 + edges 10 * (1 + node 1) + nodes 10 * 1)
+		require.Equal(t, 31, cost.Total)
+	})
+
+	t.Run("adds arguments and input fields", func(t *testing.T) {
+		cost := calculateCost(t, `{ users(filter: { name: "a", search: "b" }, expensive: true) { name } }`, nil)
+		require.Equal(t, 15, cost.Total)
+
+		cost = calculateCost(t, `query($filter: UserFilter) { users(filter: $filter) { name } }`, map[string]interface{}{
+			"filter": map[string]interface{}{"search": "b"},
+		})
+		require.Equal(t, 11, cost.Total)
+	})
+
+	t.Run("adds fragments and ignores introspection", func(t *testing.T) {
+		cost := calculateCost(t, `{ __typename ... on Query { user { ...UserFields } } } fragment UserFields on User { avatar }`, nil)
+		require.Equal(t, 4, cost.Total)
+	})
+
+	t.Run("guards against integer overflow", func(t *testing.T) {
+		cost := calculateCost(t, `{ user { friends(first: 2147483647) { friends(first: 2147483647) { friends(first: 2147483647) { name } } } } }`, nil)
+		require.Equal(t, maxInt, cost.Total)
+	})
+
+	t.Run("breaks down the cost by field", func(t *testing.T) {
+		cost := calculateCost(t, `{ user { a: friends(first: 2) { avatar } } }`, nil)
+		require.Equal(t, []FieldCost{
+			{Path: ast.Path{ast.PathName("user")}, Coordinate: "Query.user", ListSize: 1, Cost: 9},
+			{Path: ast.Path{ast.PathName("user"), ast.PathName("a")}, Coordinate: "User.friends", ListSize: 2, Cost: 8},
+			{Path: ast.Path{ast.PathName("user"), ast.PathName("a"), ast.PathName("avatar")}, Coordinate: "User.avatar", ListSize: 1, Cost: 3},
+		}, cost.Fields)
+	})
+}
+
+func TestValidateCostDirectives(t *testing.T) {
+	validate := func(input string) error {
+		schema, err := gqlparser.LoadSchema(&ast.Source{Name: "cost.graphql", Input: costDirectives + input})
+		require.Nil(t, err)
+		return ValidateCostDirectives(schema)
+	}
+
+	require.NoError(t, ValidateCostDirectives(costSchema))
+
+	require.EqualError(t, validate(`type Query { a: String @cost(weight: "heavy") }`),
+		`Query.a: @cost weight "heavy" is not a positive number`)
+	require.EqualError(t, validate(`type Query { a(b: Int @cost(weight: "-1")): String }`),
+		`Query.a(b:): @cost weight "-1" is not a positive number`)
+	require.EqualError(t, validate(`type Query { a: String @listSize(assumedSize: 1) }`),
+		`Query.a: @listSize can only be used on lists, or with sizedFields`)
+	require.EqualError(t, validate(`type Query { a: [String] @listSize(slicingArguments: ["first"]) }`),
+		`Query.a: @listSize slicing argument first is not an argument of the field`)
+	require.EqualError(t, validate(`type Query { a(first: String): [String] @listSize(slicingArguments: ["first"]) }`),
+		`Query.a: @listSize slicing argument first must be an Int`)
+	require.EqualError(t, validate(`type Query { a: Query @listSize(sizedFields: ["b"]) b: String }`),
+		`Query.a: @listSize sized field b is not a list`)
+
+	t.Run("ignores directives that are not from the specification", func(t *testing.T) {
+		schema := gqlparser.MustLoadSchema(&ast.Source{Name: "cost.graphql", Input: `
+			directive @cost(complexity: Int) on FIELD_DEFINITION
+			type Query { a: String @cost(complexity: 1) }
+		`})
+		require.NoError(t, ValidateCostDirectives(schema))
+	})
+}
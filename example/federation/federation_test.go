@@ -0,0 +1,97 @@
+package federation
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/client"
+	accounts "github.com/99designs/gqlgen/example/federation/accounts/graph"
+	accountsgen "github.com/99designs/gqlgen/example/federation/accounts/graph/generated"
+	products "github.com/99designs/gqlgen/example/federation/products/graph"
+	productsgen "github.com/99designs/gqlgen/example/federation/products/graph/generated"
+	reviews "github.com/99designs/gqlgen/example/federation/reviews/graph"
+	reviewsgen "github.com/99designs/gqlgen/example/federation/reviews/graph/generated"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/plugin/federation/gateway"
+)
+
+func TestFederation(t *testing.T) {
+	gw, err := gateway.Compose(context.Background(),
+		gateway.Subgraph{
+			Name:    "accounts",
+			Handler: handler.NewDefaultServer(accountsgen.NewExecutableSchema(accountsgen.Config{Resolvers: &accounts.Resolver{}})),
+		},
+		gateway.Subgraph{
+			Name:    "products",
+			Handler: handler.NewDefaultServer(productsgen.NewExecutableSchema(productsgen.Config{Resolvers: &products.Resolver{}})),
+		},
+		gateway.Subgraph{
+			Name:    "reviews",
+			Handler: handler.NewDefaultServer(reviewsgen.NewExecutableSchema(reviewsgen.Config{Resolvers: &reviews.Resolver{}})),
+		},
+	)
+	require.NoError(t, err)
+
+	c := client.New(gw)
+	post := func(t *testing.T, query string, options ...client.Option) string {
+		resp, err := c.RawPost(query, options...)
+		require.NoError(t, err)
+		require.Empty(t, resp.Errors)
+		data, err := json.Marshal(resp.Data)
+		require.NoError(t, err)
+		return string(data)
+	}
+
+	t.Run("can join across services", func(t *testing.T) {
+		resp := post(t, `query {
+			me {
+				username
+				reviews {
+					body
+					product {
+						name
+						upc
+					}
+				}
+			}
+		}`)
+
+		require.JSONEq(t, `{
+			"me": {
+				"username": "Me",
+				"reviews": [
+					{
+						"body": "A highly effective form of birth control.",
+						"product": {"name": "Trilby", "upc": "top-1"}
+					},
+					{
+						"body": "Fedoras are one of the most fashionable hats around and can look great with a variety of outfits.",
+						"product": {"name": "Fedora", "upc": "top-2"}
+					}
+				]
+			}
+		}`, resp)
+	})
+
+	t.Run("can resolve entities from root fields of another service", func(t *testing.T) {
+		resp := post(t, `query Products($first: Int) {
+			topProducts(first: $first) {
+				name
+				... on Product {
+					reviews { author { username } }
+				}
+			}
+		}`, client.Var("first", 2))
+
+		require.JSONEq(t, `{
+			"topProducts": [
+				{"name": "Trilby", "reviews": [{"author": {"username": "Me"}}]},
+				{"name": "Fedora", "reviews": [{"author": {"username": "Me"}}]},
+				{"name": "Boater", "reviews": [{"author": {"username": "User 7777"}}]}
+			]
+		}`, resp)
+	})
+}
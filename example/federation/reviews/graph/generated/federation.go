@@ -9,6 +9,7 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/99designs/gqlgen/example/federation/reviews/graph/model"
 	"github.com/99designs/gqlgen/plugin/federation/fedruntime"
 )
 
@@ -121,13 +122,27 @@ func (ec *executionContext) __resolve_entities(ctx context.Context, representati
 					return fmt.Errorf(`resolving Entity "User": %w`, err)
 				}
 
-				entity.Host.ID, err = ec.unmarshalNString2string(ctx, rep["host"].(map[string]interface{})["id"])
-				if err != nil {
-					return err
+				index := idx[i]
+				{
+					value, err := fedruntime.RequiredField(rep, "host", "id")
+					if err == nil {
+						if entity.Host == nil {
+							entity.Host = new(model.EmailHost)
+						}
+						entity.Host.ID, err = ec.unmarshalNString2string(ctx, value)
+					}
+					if err != nil {
+						return fedruntime.RequiresError(ctx, index, "host.id", err)
+					}
 				}
-				entity.Email, err = ec.unmarshalNString2string(ctx, rep["email"])
-				if err != nil {
-					return err
+				{
+					value, err := fedruntime.RequiredField(rep, "email")
+					if err == nil {
+						entity.Email, err = ec.unmarshalNString2string(ctx, value)
+					}
+					if err != nil {
+						return fedruntime.RequiresError(ctx, index, "email", err)
+					}
 				}
 				list[idx[i]] = entity
 				return nil
@@ -10,7 +10,7 @@ require (
 	github.com/mitchellh/mapstructure v1.4.1
 	github.com/opentracing/opentracing-go v1.2.0
 	github.com/rs/cors v1.8.0
-	github.com/stretchr/testify v1.7.0
+	github.com/stretchr/testify v1.7.1
 	github.com/vektah/dataloaden v0.3.0
 	github.com/vektah/gqlparser/v2 v2.2.0
 	sourcegraph.com/sourcegraph/appdash v0.0.0-20210831040556-ec77a7fbcadc
@@ -1,8 +1,9 @@
 module github.com/99designs/gqlgen
 
-go 1.16
+go 1.18
 
 require (
+	github.com/fasthttp/websocket v1.5.0
 	github.com/gorilla/websocket v1.4.2
 	github.com/hashicorp/golang-lru v0.5.0
 	github.com/kevinmbeaulieu/eq-go v1.0.0
@@ -11,14 +12,27 @@ require (
 	github.com/mattn/go-colorable v0.1.4
 	github.com/mattn/go-isatty v0.0.12
 	github.com/mitchellh/mapstructure v1.2.3
-	github.com/stretchr/testify v1.4.0
+	github.com/stretchr/testify v1.7.1
 	github.com/urfave/cli/v2 v2.3.0
+	github.com/valyala/fasthttp v1.34.0
 	github.com/vektah/gqlparser/v2 v2.2.0
 	golang.org/x/tools v0.1.5
+	google.golang.org/protobuf v1.28.0
 	gopkg.in/yaml.v2 v2.2.4
 )
 
 require (
 	github.com/agnivade/levenshtein v1.1.0 // indirect
+	github.com/andybalholm/brotli v1.0.4 // indirect
 	github.com/cpuguy83/go-md2man/v2 v2.0.1 // indirect
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/klauspost/compress v1.15.0 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	github.com/russross/blackfriday/v2 v2.1.0 // indirect
+	github.com/savsgio/gotils v0.0.0-20211223103454-d0aaa54c5899 // indirect
+	github.com/valyala/bytebufferpool v1.0.0 // indirect
+	golang.org/x/mod v0.4.2 // indirect
+	golang.org/x/sys v0.0.0-20220227234510-4e6760a101f9 // indirect
+	golang.org/x/xerrors v0.0.0-20200804184101-5ec99f83aff1 // indirect
+	gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c // indirect
 )
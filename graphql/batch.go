@@ -0,0 +1,98 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+)
+
+const batchCtx key = "batch_context"
+
+// Batch holds the results of the batched fields selected on the objects of a list. The generated code resolves them
+// for all the objects at once before marshalling the list, instead of calling a resolver for every object.
+type Batch struct {
+	fields map[string]*BatchResults
+}
+
+// BatchResults are the results of the batch method of a field, called with n objects. It returns either one result
+// per object, or one error per object, or both.
+type BatchResults struct {
+	field   string
+	n       int
+	results reflect.Value
+	errs    []error
+}
+
+type batchElement struct {
+	batch *Batch
+	index int
+	fc    *FieldContext
+}
+
+func NewBatch() *Batch {
+	return &Batch{fields: map[string]*BatchResults{}}
+}
+
+// Set stores the results of the field selected with alias. Nil results are ignored, so the field is resolved
+// object by object.
+func (b *Batch) Set(alias string, results *BatchResults) {
+	if results != nil {
+		b.fields[alias] = results
+	}
+}
+
+// NewBatchResults wraps the results of the batch method of field, called with n objects.
+func NewBatchResults(field string, n int, results interface{}, errs []error) *BatchResults {
+	return &BatchResults{
+		field:   field,
+		n:       n,
+		results: reflect.ValueOf(results),
+		errs:    errs,
+	}
+}
+
+// At returns the result of the i-th object the batch method was called with.
+func (r *BatchResults) At(i int) (interface{}, error) {
+	if r.errs != nil {
+		if len(r.errs) != r.n {
+			return nil, fmt.Errorf("the batch method of %s returned %d errors for %d objects", r.field, len(r.errs), r.n)
+		}
+		if r.errs[i] != nil {
+			return nil, r.errs[i]
+		}
+	}
+
+	if r.results.Kind() != reflect.Slice || r.results.Len() != r.n {
+		n := 0
+		if r.results.Kind() == reflect.Slice {
+			n = r.results.Len()
+		}
+		return nil, fmt.Errorf("the batch method of %s returned %d results for %d objects", r.field, n, r.n)
+	}
+	return r.results.Index(i).Interface(), nil
+}
+
+// WithBatch marks the object being marshalled as the i-th object of the batch of its list.
+func WithBatch(ctx context.Context, batch *Batch, i int) context.Context {
+	return context.WithValue(ctx, batchCtx, &batchElement{
+		batch: batch,
+		index: i,
+		fc:    GetFieldContext(ctx),
+	})
+}
+
+// GetBatchResults returns the batch results of the field being resolved, and the index of its object in them. The
+// results are nil when the field was not resolved for the whole list, in which case it must be resolved on its own.
+func GetBatchResults(ctx context.Context) (*BatchResults, int) {
+	e, _ := ctx.Value(batchCtx).(*batchElement)
+	fc := GetFieldContext(ctx)
+	if e == nil || fc == nil || fc.Parent != e.fc {
+		return nil, 0
+	}
+
+	results := e.batch.fields[fc.Field.Alias]
+	if results == nil {
+		return nil, 0
+	}
+	return results, e.index
+}
@@ -0,0 +1,94 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// CacheScope tells who can cache a response: any cache for public responses, only the cache of the client for
+// private ones.
+type CacheScope string
+
+const (
+	CacheScopePublic  CacheScope = "PUBLIC"
+	CacheScopePrivate CacheScope = "PRIVATE"
+)
+
+const cachePolicyCtx key = "cache_policy_context"
+
+// CachePolicy is how long and by whom the response of an operation can be cached. Every field resolved restricts
+// it further: the policy has the lowest max age of the fields, and is private as soon as one field is.
+type CachePolicy struct {
+	mu     sync.Mutex
+	maxAge *int
+	scope  CacheScope
+}
+
+func NewCachePolicy() *CachePolicy {
+	return &CachePolicy{scope: CacheScopePublic}
+}
+
+// RestrictMaxAge lowers the max age of the response to maxAge seconds, unless it is lower already.
+func (p *CachePolicy) RestrictMaxAge(maxAge int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.maxAge == nil || maxAge < *p.maxAge {
+		p.maxAge = &maxAge
+	}
+}
+
+// RestrictScope makes the response private when scope is private.
+func (p *CachePolicy) RestrictScope(scope CacheScope) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if scope == CacheScopePrivate {
+		p.scope = CacheScopePrivate
+	}
+}
+
+// MaxAge returns the max age of the response in seconds, and false when no field restricted it.
+func (p *CachePolicy) MaxAge() (int, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.maxAge == nil {
+		return 0, false
+	}
+	return *p.maxAge, true
+}
+
+func (p *CachePolicy) Scope() CacheScope {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.scope
+}
+
+// Cacheable tells whether the response can be cached at all, which needs a positive max age.
+func (p *CachePolicy) Cacheable() bool {
+	maxAge, ok := p.MaxAge()
+	return ok && maxAge > 0
+}
+
+// Header returns the value of the Cache-Control header of the response, or an empty string when it can't be cached.
+func (p *CachePolicy) Header() string {
+	if !p.Cacheable() {
+		return ""
+	}
+	maxAge, _ := p.MaxAge()
+	return fmt.Sprintf("max-age=%d, %s", maxAge, strings.ToLower(string(p.Scope())))
+}
+
+// WithCachePolicy attaches the cache policy of the operation to ctx.
+func WithCachePolicy(ctx context.Context, policy *CachePolicy) context.Context {
+	return context.WithValue(ctx, cachePolicyCtx, policy)
+}
+
+// GetCachePolicy returns the cache policy of the operation, so resolvers can restrict it. It is nil unless the
+// CacheControl extension is used.
+func GetCachePolicy(ctx context.Context) *CachePolicy {
+	if val, ok := ctx.Value(cachePolicyCtx).(*CachePolicy); ok {
+		return val
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+const loadersCtx key = "loaders_context"
+
+// Loaders are the loaders of an operation, by name. They are created the first time they are used, so operations
+// only pay for the loaders they need.
+type Loaders struct {
+	mu      sync.Mutex
+	configs map[string]func() interface{}
+	loaders map[string]interface{}
+}
+
+func NewLoaders() *Loaders {
+	return &Loaders{
+		configs: map[string]func() interface{}{},
+		loaders: map[string]interface{}{},
+	}
+}
+
+// AddLoader registers the loader called name.
+func AddLoader[K comparable, V any](loaders *Loaders, name string, config LoaderConfig[K, V]) {
+	loaders.mu.Lock()
+	defer loaders.mu.Unlock()
+	loaders.configs[name] = func() interface{} {
+		return NewLoader(config)
+	}
+	delete(loaders.loaders, name)
+}
+
+// WithLoaders attaches the loaders of the operation to ctx.
+func WithLoaders(ctx context.Context, loaders *Loaders) context.Context {
+	return context.WithValue(ctx, loadersCtx, loaders)
+}
+
+// GetLoaders returns the loaders of the operation, or nil if there are none.
+func GetLoaders(ctx context.Context) *Loaders {
+	if val, ok := ctx.Value(loadersCtx).(*Loaders); ok {
+		return val
+	}
+	return nil
+}
+
+// GetLoader returns the loader called name of the operation. It panics when no loader was added with that name and
+// these key and value types, as this is a programming error.
+func GetLoader[K comparable, V any](ctx context.Context, name string) *Loader[K, V] {
+	loaders := GetLoaders(ctx)
+	if loaders == nil {
+		panic("missing loaders in context, add the Loaders extension to the server")
+	}
+
+	loaders.mu.Lock()
+	defer loaders.mu.Unlock()
+
+	loader, ok := loaders.loaders[name]
+	if !ok {
+		config, ok := loaders.configs[name]
+		if !ok {
+			panic(fmt.Errorf("there is no loader called %s", name))
+		}
+		loader = config()
+		loaders.loaders[name] = loader
+	}
+
+	typed, ok := loader.(*Loader[K, V])
+	if !ok {
+		panic(fmt.Errorf("the loader called %s is a %T, not a %T", name, loader, typed))
+	}
+	return typed
+}
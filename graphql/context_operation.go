@@ -3,6 +3,7 @@ package graphql
 import (
 	"context"
 	"errors"
+	"net/http"
 
 	"github.com/vektah/gqlparser/v2/ast"
 )
@@ -22,9 +23,27 @@ type OperationContext struct {
 	ResolverMiddleware     FieldMiddleware
 	RootResolverMiddleware RootFieldMiddleware
 
+	// IncrementalDelivery is set by transports that can send more than one response for a query or mutation. When it
+	// is false @defer and @stream are ignored, and the whole result is sent in a single response.
+	IncrementalDelivery bool
+
+	// StreamData is set by transports writing the data of responses straight to the client. The data of queries and
+	// mutations is then returned in StreamedData instead of being buffered into Data.
+	StreamData bool
+
+	// Scheduler starts the goroutines resolving fields, within the concurrency limits of the server.
+	Scheduler *Scheduler
+
+	// Headers of the HTTP request the operation was sent with, they are not set by the websocket transport.
+	Headers http.Header
+
 	Stats Stats
 }
 
+func (c *OperationContext) incrementalDelivery() bool {
+	return c.IncrementalDelivery && c.Operation != nil && c.Operation.Operation != ast.Subscription
+}
+
 func (c *OperationContext) Validate(ctx context.Context) error {
 	if c.Doc == nil {
 		return errors.New("field 'Doc'is required")
@@ -36,6 +36,16 @@ func WithResponseContext(ctx context.Context, presenterFunc ErrorPresenterFunc,
 	})
 }
 
+// withSubsequentResponseContext returns a context for resolving part of a result that is delivered in a later
+// payload, which collects its own errors and extensions.
+func withSubsequentResponseContext(ctx context.Context) context.Context {
+	c := getResponseContext(ctx)
+	return context.WithValue(ctx, resultCtx, &responseContext{
+		errorPresenter: c.errorPresenter,
+		recover:        c.recover,
+	})
+}
+
 // AddErrorf writes a formatted error to the client, first passing it through the error presenter.
 func AddErrorf(ctx context.Context, format string, args ...interface{}) {
 	AddError(ctx, fmt.Errorf(format, args...))
@@ -33,21 +33,23 @@ func collectFields(reqCtx *OperationContext, selSet ast.SelectionSet, satisfies
 				continue
 			}
 			f := getOrCreateAndAppendField(&groupedFields, sel.Name, sel.Alias, sel.ObjectDefinition, func() CollectedField {
-				return CollectedField{Field: sel}
+				return CollectedField{Field: sel, Streamable: getStreamable(reqCtx, sel.Directives)}
 			})
 
 			f.Selections = append(f.Selections, sel.SelectionSet...)
+			f.Deferrable = nil
 
 		case *ast.InlineFragment:
 			if !shouldIncludeNode(sel.Directives, reqCtx.Variables) {
 				continue
 			}
-			if len(satisfies) > 0 && !instanceOf(sel.TypeCondition, satisfies) {
+			// an inline fragment without a type condition applies to the enclosing type, eg ... @defer { name }
+			if len(satisfies) > 0 && sel.TypeCondition != "" && !instanceOf(sel.TypeCondition, satisfies) {
 				continue
 			}
+			deferrable := getDeferrable(reqCtx, sel.Directives)
 			for _, childField := range collectFields(reqCtx, sel.SelectionSet, satisfies, visited) {
-				f := getOrCreateAndAppendField(&groupedFields, childField.Name, childField.Alias, childField.ObjectDefinition, func() CollectedField { return childField })
-				f.Selections = append(f.Selections, childField.Selections...)
+				mergeCollectedField(&groupedFields, childField, deferrable)
 			}
 
 		case *ast.FragmentSpread:
@@ -70,9 +72,9 @@ func collectFields(reqCtx *OperationContext, selSet ast.SelectionSet, satisfies
 				continue
 			}
 
+			deferrable := getDeferrable(reqCtx, sel.Directives)
 			for _, childField := range collectFields(reqCtx, fragment.SelectionSet, satisfies, visited) {
-				f := getOrCreateAndAppendField(&groupedFields, childField.Name, childField.Alias, childField.ObjectDefinition, func() CollectedField { return childField })
-				f.Selections = append(f.Selections, childField.Selections...)
+				mergeCollectedField(&groupedFields, childField, deferrable)
 			}
 
 		default:
@@ -87,6 +89,41 @@ type CollectedField struct {
 	*ast.Field
 
 	Selections ast.SelectionSet
+
+	// Deferrable is set when the field was only selected from fragments marked with @defer.
+	Deferrable *Deferrable
+	// Streamable is set when the field is a list marked with @stream.
+	Streamable *Streamable
+}
+
+// Deferrable holds the arguments of the @defer directive a field was collected from.
+type Deferrable struct {
+	Label string
+}
+
+// Streamable holds the arguments of the @stream directive on a list field.
+type Streamable struct {
+	Label        string
+	InitialCount int
+}
+
+// mergeCollectedField adds a field collected from a fragment. Fields from a deferred fragment stay deferred unless
+// they were already deferred by a nested fragment, and a field selected outside of any deferred fragment is never
+// deferred.
+func mergeCollectedField(c *[]CollectedField, childField CollectedField, deferrable *Deferrable) {
+	if childField.Deferrable == nil {
+		childField.Deferrable = deferrable
+	}
+
+	isNew := false
+	f := getOrCreateAndAppendField(c, childField.Name, childField.Alias, childField.ObjectDefinition, func() CollectedField {
+		isNew = true
+		return childField
+	})
+	f.Selections = append(f.Selections, childField.Selections...)
+	if !isNew && childField.Deferrable == nil {
+		f.Deferrable = nil
+	}
 }
 
 func instanceOf(val string, satisfies []string) bool {
@@ -127,6 +164,45 @@ func getOrCreateAndAppendField(c *[]CollectedField, name string, alias string, o
 	return &(*c)[len(*c)-1]
 }
 
+func getDeferrable(reqCtx *OperationContext, directives ast.DirectiveList) *Deferrable {
+	d := directives.ForName("defer")
+	if d == nil || !reqCtx.incrementalDelivery() || !resolveIfArgument(d, reqCtx.Variables) {
+		return nil
+	}
+
+	return &Deferrable{
+		Label: resolveLabelArgument(d, reqCtx.Variables),
+	}
+}
+
+func getStreamable(reqCtx *OperationContext, directives ast.DirectiveList) *Streamable {
+	d := directives.ForName("stream")
+	if d == nil || !reqCtx.incrementalDelivery() || !resolveIfArgument(d, reqCtx.Variables) {
+		return nil
+	}
+
+	s := &Streamable{
+		Label: resolveLabelArgument(d, reqCtx.Variables),
+	}
+	if arg := d.Arguments.ForName("initialCount"); arg != nil {
+		value, err := arg.Value.Value(reqCtx.Variables)
+		if err != nil {
+			panic(err)
+		}
+		if value == nil {
+			return s
+		}
+		count, err := UnmarshalInt(value)
+		if err != nil {
+			panic(fmt.Sprintf("stream: argument 'initialCount' is not an integer: %s", err))
+		}
+		if count > 0 {
+			s.InitialCount = count
+		}
+	}
+	return s
+}
+
 func shouldIncludeNode(directives ast.DirectiveList, variables map[string]interface{}) bool {
 	if len(directives) == 0 {
 		return true
@@ -148,6 +224,10 @@ func shouldIncludeNode(directives ast.DirectiveList, variables map[string]interf
 func resolveIfArgument(d *ast.Directive, variables map[string]interface{}) bool {
 	arg := d.Arguments.ForName("if")
 	if arg == nil {
+		if d.Name == "defer" || d.Name == "stream" {
+			// if is optional and defaults to true for incremental delivery directives
+			return true
+		}
 		panic(fmt.Sprintf("%s: argument 'if' not defined", d.Name))
 	}
 	value, err := arg.Value.Value(variables)
@@ -160,3 +240,16 @@ func resolveIfArgument(d *ast.Directive, variables map[string]interface{}) bool
 	}
 	return ret
 }
+
+func resolveLabelArgument(d *ast.Directive, variables map[string]interface{}) string {
+	arg := d.Arguments.ForName("label")
+	if arg == nil {
+		return ""
+	}
+	value, err := arg.Value.Value(variables)
+	if err != nil {
+		panic(err)
+	}
+	label, _ := value.(string)
+	return label
+}
@@ -20,6 +20,10 @@ type Executor struct {
 	errorPresenter graphql.ErrorPresenterFunc
 	recoverFunc    graphql.RecoverFunc
 	queryCache     graphql.Cache
+
+	concurrencyLimit       int
+	serverConcurrencyLimit graphql.ConcurrencyLimit
+	inlineFields           func(field graphql.CollectedField) bool
 }
 
 var _ graphql.GraphExecutor = &Executor{}
@@ -43,9 +47,12 @@ func (e *Executor) CreateOperationContext(ctx context.Context, params *graphql.R
 		RecoverFunc:            e.recoverFunc,
 		ResolverMiddleware:     e.ext.fieldMiddleware,
 		RootResolverMiddleware: e.ext.rootFieldMiddleware,
+		Scheduler:              graphql.NewScheduler(graphql.NewConcurrencyLimit(e.concurrencyLimit), e.serverConcurrencyLimit, e.inlineFields),
+		Headers:                params.Headers,
 		Stats: graphql.Stats{
 			Read:           params.ReadTime,
 			OperationStart: graphql.GetStartTime(ctx),
+			Batch:          params.Batch,
 		},
 	}
 	ctx = graphql.WithOperationContext(ctx, rc)
@@ -90,14 +97,20 @@ func (e *Executor) CreateOperationContext(ctx context.Context, params *graphql.R
 func (e *Executor) DispatchOperation(ctx context.Context, rc *graphql.OperationContext) (graphql.ResponseHandler, context.Context) {
 	ctx = graphql.WithOperationContext(ctx, rc)
 
-	var innerCtx context.Context
+	// interceptors answering the operation themselves without a precomputed response never call the handler
+	innerCtx := ctx
 	res := e.ext.operationMiddleware(ctx, func(ctx context.Context) graphql.ResponseHandler {
 		innerCtx = ctx
 
-		tmpResponseContext := graphql.WithResponseContext(ctx, e.errorPresenter, e.recoverFunc)
-		responses := e.es.Exec(tmpResponseContext)
-		if errs := graphql.GetErrors(tmpResponseContext); errs != nil {
-			return graphql.OneShot(&graphql.Response{Errors: errs})
+		var responses graphql.ResponseHandler
+		if resp := graphql.GetPrecomputedResponse(ctx); resp != nil {
+			responses = graphql.OneShot(resp)
+		} else {
+			tmpResponseContext := graphql.WithResponseContext(ctx, e.errorPresenter, e.recoverFunc)
+			responses = e.es.Exec(tmpResponseContext)
+			if errs := graphql.GetErrors(tmpResponseContext); errs != nil {
+				return graphql.OneShot(&graphql.Response{Errors: errs})
+			}
 		}
 
 		return func(ctx context.Context) *graphql.Response {
@@ -155,6 +168,24 @@ func (e *Executor) SetRecoverFunc(f graphql.RecoverFunc) {
 	e.recoverFunc = f
 }
 
+// SetConcurrencyLimit limits the number of goroutines resolving the fields of each operation, 0 means no limit. Once
+// it is reached fields are resolved by the goroutine resolving their parent.
+func (e *Executor) SetConcurrencyLimit(limit int) {
+	e.concurrencyLimit = limit
+}
+
+// SetServerConcurrencyLimit limits the number of goroutines resolving fields across all the operations, 0 means no
+// limit.
+func (e *Executor) SetServerConcurrencyLimit(limit int) {
+	e.serverConcurrencyLimit = graphql.NewConcurrencyLimit(limit)
+}
+
+// SetInlineFields sets which of the fields with resolvers are cheap enough to be resolved by the goroutine resolving
+// their parent, instead of a goroutine of their own.
+func (e *Executor) SetInlineFields(inline func(field graphql.CollectedField) bool) {
+	e.inlineFields = inline
+}
+
 // parseQuery decodes the incoming query and validates it, pulling from cache if present.
 //
 // NOTE: This should NOT look at variables, they will change per request. It should only parse and validate
@@ -27,24 +27,38 @@ func (m *FieldSet) Concurrently(i int, f func() Marshaler) {
 	m.delayed = append(m.delayed, delayedResult{i: i, f: f})
 }
 
+// Dispatch resolves the fields added with Concurrently, starting a goroutine for each of them.
 func (m *FieldSet) Dispatch() {
+	m.DispatchWith(nil)
+}
+
+// DispatchWith resolves the fields added with Concurrently, in the goroutines the scheduler allows.
+func (m *FieldSet) DispatchWith(scheduler *Scheduler) {
 	if len(m.delayed) == 1 {
 		// only one concurrent task, no need to spawn a goroutine or deal create waitgroups
 		d := m.delayed[0]
 		m.Values[d.i] = d.f()
 	} else if len(m.delayed) > 1 {
-		// more than one concurrent task, use the main goroutine to do one, only spawn goroutines for the others
+		// more than one concurrent task, use the main goroutine to do one and the inline ones, only spawn goroutines
+		// for the others
 
 		var wg sync.WaitGroup
+		var inline []delayedResult
 		for _, d := range m.delayed[1:] {
-			wg.Add(1)
-			go func(d delayedResult) {
+			if scheduler.Inline(m.fields[d.i]) {
+				inline = append(inline, d)
+				continue
+			}
+			d := d
+			scheduler.Go(&wg, func() {
 				m.Values[d.i] = d.f()
-				wg.Done()
-			}(d)
+			})
 		}
 
 		m.Values[m.delayed[0].i] = m.delayed[0].f()
+		for _, d := range inline {
+			m.Values[d.i] = d.f()
+		}
 		wg.Wait()
 	}
 }
@@ -29,6 +29,8 @@ type (
 		Extensions    map[string]interface{} `json:"extensions"`
 
 		ReadTime TraceTiming `json:"-"`
+		Headers  http.Header `json:"-"`
+		Batch    *BatchStats `json:"-"`
 	}
 
 	GraphExecutor interface {
@@ -0,0 +1,122 @@
+package apollofederatedtracingv1
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// Trace is the part of the Trace message from Apollo's reports.proto that subgraphs send to the gateway. Field numbers
+// match the ones of the protobuf definition.
+type Trace struct {
+	StartTime  time.Time // 4
+	EndTime    time.Time // 3
+	DurationNs uint64    // 11
+	Root       *Node     // 14
+}
+
+// Node is a field of the response, or a list item when Index is set. The root node has neither a response name nor
+// an index.
+type Node struct {
+	ResponseName      string   // 1
+	Index             *uint32  // 2
+	OriginalFieldName string   // 14, only set when the field is aliased
+	Type              string   // 3
+	ParentType        string   // 13
+	StartTime         uint64   // 8, nanoseconds since the start of the trace
+	EndTime           uint64   // 9, nanoseconds since the start of the trace
+	Error             []*Error // 11
+	Child             []*Node  // 12
+}
+
+type Error struct {
+	Message  string      // 1
+	Location []*Location // 2
+	JSON     string      // 4
+}
+
+type Location struct {
+	Line   uint32 // 1
+	Column uint32 // 2
+}
+
+// Marshal encodes the trace in the protobuf wire format.
+func (t *Trace) Marshal() []byte {
+	var b []byte
+	b = appendTimestamp(b, 3, t.EndTime)
+	b = appendTimestamp(b, 4, t.StartTime)
+	b = appendVarint(b, 11, t.DurationNs)
+	if t.Root != nil {
+		b = appendMessage(b, 14, t.Root.marshal())
+	}
+	return b
+}
+
+func (n *Node) marshal() []byte {
+	var b []byte
+	if n.ResponseName != "" {
+		b = appendString(b, 1, n.ResponseName)
+	} else if n.Index != nil {
+		b = protowire.AppendTag(b, 2, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(*n.Index))
+	}
+	b = appendString(b, 3, n.Type)
+	b = appendVarint(b, 8, n.StartTime)
+	b = appendVarint(b, 9, n.EndTime)
+	for _, err := range n.Error {
+		b = appendMessage(b, 11, err.marshal())
+	}
+	for _, child := range n.Child {
+		b = appendMessage(b, 12, child.marshal())
+	}
+	b = appendString(b, 13, n.ParentType)
+	b = appendString(b, 14, n.OriginalFieldName)
+	return b
+}
+
+func (e *Error) marshal() []byte {
+	var b []byte
+	b = appendString(b, 1, e.Message)
+	for _, loc := range e.Location {
+		var l []byte
+		l = appendVarint(l, 1, uint64(loc.Line))
+		l = appendVarint(l, 2, uint64(loc.Column))
+		b = appendMessage(b, 2, l)
+	}
+	b = appendString(b, 4, e.JSON)
+	return b
+}
+
+// appendTimestamp appends a google.protobuf.Timestamp
+func appendTimestamp(b []byte, num protowire.Number, t time.Time) []byte {
+	if t.IsZero() {
+		return b
+	}
+	var ts []byte
+	ts = appendVarint(ts, 1, uint64(t.Unix()))
+	ts = appendVarint(ts, 2, uint64(t.Nanosecond()))
+	return appendMessage(b, num, ts)
+}
+
+// appendVarint appends a varint field, leaving it out when it has the default value like proto3 does
+func appendVarint(b []byte, num protowire.Number, v uint64) []byte {
+	if v == 0 {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.VarintType)
+	return protowire.AppendVarint(b, v)
+}
+
+// appendString appends a string field, leaving it out when it is empty like proto3 does
+func appendString(b []byte, num protowire.Number, v string) []byte {
+	if v == "" {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendString(b, v)
+}
+
+func appendMessage(b []byte, num protowire.Number, m []byte) []byte {
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendBytes(b, m)
+}
@@ -0,0 +1,35 @@
+package apollofederatedtracingv1_test
+
+import (
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql/handler/apollofederatedtracingv1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTraceMarshal(t *testing.T) {
+	zero := uint32(0)
+	trace := &apollofederatedtracingv1.Trace{
+		DurationNs: 5,
+		Root: &apollofederatedtracingv1.Node{
+			Child: []*apollofederatedtracingv1.Node{
+				{ResponseName: "a", Type: "String", ParentType: "Query", StartTime: 1, EndTime: 2},
+				{Index: &zero},
+			},
+		},
+	}
+
+	expected := []byte{
+		0x58, 0x05, // duration_ns
+		0x72, 0x1c, // root
+		0x62, 0x16, // child
+		0x0a, 0x01, 'a', // response_name
+		0x1a, 0x06, 'S', 't', 'r', 'i', 'n', 'g', // type
+		0x40, 0x01, // start_time
+		0x48, 0x02, // end_time
+		0x6a, 0x05, 'Q', 'u', 'e', 'r', 'y', // parent_type
+		0x62, 0x02, // child
+		0x10, 0x00, // index
+	}
+	require.Equal(t, expected, trace.Marshal())
+}
@@ -0,0 +1,158 @@
+package apollofederatedtracingv1
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+const (
+	// IncludeTraceHeader is sent by the gateway to ask subgraphs for a federated trace
+	IncludeTraceHeader = "apollo-federation-include-trace"
+	traceVersion       = "ftv1"
+)
+
+type (
+	// Tracer adds Apollo federated traces (ftv1) to the responses of subgraphs. Traces are only built when the
+	// gateway asks for them with the apollo-federation-include-trace header, and are sent in the ftv1 response
+	// extension as a base64 encoded protobuf message.
+	Tracer struct{}
+
+	treeBuilder struct {
+		mu    sync.Mutex
+		start time.Time
+		trace *Trace
+		nodes map[string]*Node
+	}
+
+	treeBuilderKey struct{}
+)
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.ResponseInterceptor
+	graphql.FieldInterceptor
+} = Tracer{}
+
+func (Tracer) ExtensionName() string {
+	return "ApolloFederatedTracingV1"
+}
+
+func (Tracer) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (Tracer) InterceptField(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+	tb, ok := ctx.Value(treeBuilderKey{}).(*treeBuilder)
+	if !ok {
+		return next(ctx)
+	}
+
+	start := graphql.Now()
+	defer func() {
+		end := graphql.Now()
+		fc := graphql.GetFieldContext(ctx)
+
+		tb.mu.Lock()
+		defer tb.mu.Unlock()
+
+		node := tb.node(fc.Path())
+		node.ParentType = fc.Object
+		if fc.Field.Alias != fc.Field.Name {
+			node.OriginalFieldName = fc.Field.Name
+		}
+		if fc.Field.Definition != nil {
+			node.Type = fc.Field.Definition.Type.String()
+		}
+		node.StartTime = uint64(start.Sub(tb.start))
+		node.EndTime = uint64(end.Sub(tb.start))
+	}()
+
+	return next(ctx)
+}
+
+func (Tracer) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	if !graphql.HasOperationContext(ctx) {
+		return next(ctx)
+	}
+	rc := graphql.GetOperationContext(ctx)
+	if rc.Headers.Get(IncludeTraceHeader) != traceVersion {
+		return next(ctx)
+	}
+
+	start := rc.Stats.OperationStart
+	if start.IsZero() {
+		start = graphql.Now()
+	}
+	tb := &treeBuilder{
+		start: start,
+		trace: &Trace{StartTime: start, Root: &Node{}},
+		nodes: map[string]*Node{},
+	}
+
+	resp := next(context.WithValue(ctx, treeBuilderKey{}, tb))
+	if resp == nil {
+		return nil
+	}
+
+	end := graphql.Now()
+	tb.trace.EndTime = end
+	tb.trace.DurationNs = uint64(end.Sub(start))
+
+	tb.mu.Lock()
+	for _, err := range resp.Errors {
+		tb.addError(err)
+	}
+	tb.mu.Unlock()
+
+	if resp.Extensions == nil {
+		resp.Extensions = map[string]interface{}{}
+	}
+	resp.Extensions[traceVersion] = base64.StdEncoding.EncodeToString(tb.trace.Marshal())
+	return resp
+}
+
+// node returns the node at path, creating it and its parents if needed
+func (tb *treeBuilder) node(path ast.Path) *Node {
+	if len(path) == 0 {
+		return tb.trace.Root
+	}
+
+	key := path.String()
+	if node, ok := tb.nodes[key]; ok {
+		return node
+	}
+
+	parent := tb.node(path[:len(path)-1])
+	node := &Node{}
+	switch element := path[len(path)-1].(type) {
+	case ast.PathName:
+		node.ResponseName = string(element)
+	case ast.PathIndex:
+		index := uint32(element)
+		node.Index = &index
+	}
+	parent.Child = append(parent.Child, node)
+	tb.nodes[key] = node
+	return node
+}
+
+// addError attaches an error to the node of the field it happened in, or to the root node
+func (tb *treeBuilder) addError(err *gqlerror.Error) {
+	traceErr := &Error{Message: err.Message}
+	for _, loc := range err.Locations {
+		traceErr.Location = append(traceErr.Location, &Location{Line: uint32(loc.Line), Column: uint32(loc.Column)})
+	}
+	if b, jsonErr := json.Marshal(err); jsonErr == nil {
+		traceErr.JSON = string(b)
+	}
+
+	node := tb.node(err.Path)
+	node.Error = append(node.Error, traceErr)
+}
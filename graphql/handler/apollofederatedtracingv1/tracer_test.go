@@ -0,0 +1,128 @@
+package apollofederatedtracingv1_test
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/apollofederatedtracingv1"
+	"github.com/99designs/gqlgen/graphql/handler/testserver"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+func TestTracer(t *testing.T) {
+	now := time.Unix(0, 0)
+	graphql.Now = func() time.Time {
+		defer func() {
+			now = now.Add(100 * time.Nanosecond)
+		}()
+		return now
+	}
+	defer func() {
+		graphql.Now = time.Now
+	}()
+
+	h := testserver.New()
+	h.AddTransport(transport.POST{})
+	h.Use(apollofederatedtracingv1.Tracer{})
+
+	t.Run("without the header", func(t *testing.T) {
+		resp := doRequest(h, `{"query":"{ name }"}`, "")
+		require.Equal(t, `{"data":{"name":"test"}}`, resp.Body.String())
+	})
+
+	t.Run("with the header", func(t *testing.T) {
+		resp := doRequest(h, `{"query":"{ name }"}`, "ftv1")
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+
+		var respData struct {
+			Extensions struct {
+				FTV1 string `json:"ftv1"`
+			} `json:"extensions"`
+		}
+		require.NoError(t, json.Unmarshal(resp.Body.Bytes(), &respData))
+		b, err := base64.StdEncoding.DecodeString(respData.Extensions.FTV1)
+		require.NoError(t, err)
+
+		trace := decodeMessage(t, b)
+		require.Contains(t, trace, protowire.Number(3), "end_time")
+		require.Contains(t, trace, protowire.Number(4), "start_time")
+		require.NotZero(t, trace[11][0], "duration_ns")
+
+		root := decodeMessage(t, trace[14][0].([]byte))
+		require.Len(t, root[12], 1)
+		field := decodeMessage(t, root[12][0].([]byte))
+		require.Equal(t, []byte("name"), field[1][0])
+		require.Equal(t, []byte("String!"), field[3][0])
+		require.Equal(t, []byte("Query"), field[13][0])
+		require.Less(t, field[8][0].(uint64), field[9][0].(uint64))
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		resp := doRequest(h, `{"query":"{ title }"}`, "ftv1")
+		require.Equal(t, http.StatusUnprocessableEntity, resp.Code, resp.Body.String())
+
+		var respData struct {
+			Extensions struct {
+				FTV1 string `json:"ftv1"`
+			} `json:"extensions"`
+		}
+		require.NoError(t, json.Unmarshal(resp.Body.Bytes(), &respData))
+		b, err := base64.StdEncoding.DecodeString(respData.Extensions.FTV1)
+		require.NoError(t, err)
+
+		root := decodeMessage(t, decodeMessage(t, b)[14][0].([]byte))
+		require.Len(t, root[11], 1)
+		traceErr := decodeMessage(t, root[11][0].([]byte))
+		require.Equal(t, []byte(`Cannot query field "title" on type "Query".`), traceErr[1][0])
+		location := decodeMessage(t, traceErr[2][0].([]byte))
+		require.Equal(t, uint64(1), location[1][0])
+		require.Equal(t, uint64(3), location[2][0])
+	})
+}
+
+// decodeMessage decodes the fields of a protobuf message, varints as uint64 and everything else as bytes
+func decodeMessage(t *testing.T, b []byte) map[protowire.Number][]interface{} {
+	t.Helper()
+	fields := map[protowire.Number][]interface{}{}
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		require.GreaterOrEqual(t, n, 0)
+		b = b[n:]
+
+		switch typ {
+		case protowire.VarintType:
+			v, n := protowire.ConsumeVarint(b)
+			require.GreaterOrEqual(t, n, 0)
+			fields[num] = append(fields[num], v)
+			b = b[n:]
+		case protowire.BytesType:
+			v, n := protowire.ConsumeBytes(b)
+			require.GreaterOrEqual(t, n, 0)
+			fields[num] = append(fields[num], v)
+			b = b[n:]
+		default:
+			t.Fatalf("unexpected wire type %d", typ)
+		}
+	}
+	return fields
+}
+
+func doRequest(handler http.Handler, body string, includeTrace string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(body))
+	r.Header.Set("Content-Type", "application/json")
+	if includeTrace != "" {
+		r.Header.Set(apollofederatedtracingv1.IncludeTraceHeader, includeTrace)
+	}
+	w := httptest.NewRecorder()
+
+	handler.ServeHTTP(w, r)
+	return w
+}
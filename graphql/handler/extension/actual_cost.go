@@ -0,0 +1,176 @@
+package extension
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/99designs/gqlgen/complexity"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+const errRateLimited = "RATE_LIMITED"
+
+// ActualCost measures what operations actually cost while they execute, using the same weights as
+// complexity.CalculateCost: every resolved field adds its weight and the weight of its arguments, and list fields add
+// the weight of every item they returned rather than an estimated size.
+//
+// The estimated and actual costs are reported in the cost extension of every response. When a Limiter is set, the
+// actual cost of each operation is charged to the client identified by Key, and clients that have exhausted their
+// budget are rejected with a RATE_LIMITED error.
+type ActualCost struct {
+	// Limiter charges clients for the operations they run, it is optional.
+	Limiter RateLimiter
+
+	// Key identifies the client the operation is charged to, eg by its user id or ip address. It is required when
+	// Limiter is set.
+	Key func(ctx context.Context) string
+
+	es graphql.ExecutableSchema
+}
+
+var _ interface {
+	graphql.OperationContextMutator
+	graphql.ResponseInterceptor
+	graphql.FieldInterceptor
+	graphql.HandlerExtension
+} = &ActualCost{}
+
+const actualCostExtension = "ActualCost"
+
+type ActualCostStats struct {
+	// The cost of this request estimated before it was executed
+	Estimated int
+
+	// The cost of the fields resolved so far, it is updated atomically while the request is executing
+	Actual int64
+
+	// The key this request is charged to, empty without a Limiter
+	Key string
+
+	// charged is the part of Actual that was already charged to the limiter
+	charged int64
+	mu      sync.Mutex
+}
+
+func (a ActualCost) ExtensionName() string {
+	return actualCostExtension
+}
+
+func (a *ActualCost) Validate(schema graphql.ExecutableSchema) error {
+	if a.Limiter != nil && a.Key == nil {
+		return fmt.Errorf("ActualCost.Key can not be nil when a Limiter is set")
+	}
+	a.es = schema
+	return nil
+}
+
+func (a ActualCost) MutateOperationContext(ctx context.Context, rc *graphql.OperationContext) *gqlerror.Error {
+	stats := &ActualCostStats{
+		Estimated: complexity.CalculateCost(a.es, rc.Operation, rc.Variables).Total,
+	}
+	rc.Stats.SetExtension(actualCostExtension, stats)
+
+	if a.Limiter == nil {
+		return nil
+	}
+
+	stats.Key = a.Key(ctx)
+	allowed, err := a.Limiter.Allow(ctx, stats.Key, stats.Estimated)
+	if err != nil {
+		return gqlerror.Errorf("unable to check rate limit: %s", err.Error())
+	}
+	if !allowed {
+		err := gqlerror.Errorf("rate limit exceeded, try again later")
+		errcode.Set(err, errRateLimited)
+		return err
+	}
+	return nil
+}
+
+func (a ActualCost) InterceptField(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+	res, err := next(ctx)
+
+	stats := GetActualCostStats(ctx)
+	fc := graphql.GetFieldContext(ctx)
+	if stats == nil || fc == nil || fc.Field.Field == nil || fc.Field.Definition == nil || strings.HasPrefix(fc.Field.Name, "__") {
+		return res, err
+	}
+
+	items := 1
+	if fc.Field.Definition.Type.Elem != nil {
+		items = listLength(res)
+	}
+
+	rc := graphql.GetOperationContext(ctx)
+	schema := a.es.Schema()
+	cost := complexity.FieldWeight(schema, fc.Field.Definition)*items +
+		complexity.ArgumentsCost(schema, fc.Field.Field, rc.Variables)
+	atomic.AddInt64(&stats.Actual, int64(cost))
+
+	return res, err
+}
+
+func (a ActualCost) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	resp := next(ctx)
+
+	stats := GetActualCostStats(ctx)
+	if resp == nil || stats == nil {
+		return resp
+	}
+
+	actual := atomic.LoadInt64(&stats.Actual)
+	if resp.Extensions == nil {
+		resp.Extensions = map[string]interface{}{}
+	}
+	resp.Extensions["cost"] = map[string]interface{}{
+		"estimated": stats.Estimated,
+		"actual":    actual,
+	}
+
+	if a.Limiter == nil {
+		return resp
+	}
+
+	// incremental deliveries and subscriptions are charged for what was resolved since their previous response
+	stats.mu.Lock()
+	defer stats.mu.Unlock()
+	if actual > stats.charged {
+		if err := a.Limiter.Charge(ctx, stats.Key, int(actual-stats.charged)); err != nil {
+			resp.Errors = append(resp.Errors, gqlerror.Errorf("unable to charge operation cost: %s", err.Error()))
+			return resp
+		}
+		stats.charged = actual
+	}
+	return resp
+}
+
+// listLength returns the number of items in a list returned by a resolver
+func listLength(res interface{}) int {
+	v := reflect.ValueOf(res)
+	for v.Kind() == reflect.Ptr && !v.IsNil() {
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array:
+		return v.Len()
+	case reflect.Invalid, reflect.Ptr:
+		return 0
+	}
+	return 1
+}
+
+func GetActualCostStats(ctx context.Context) *ActualCostStats {
+	if !graphql.HasOperationContext(ctx) {
+		return nil
+	}
+	rc := graphql.GetOperationContext(ctx)
+
+	s, _ := rc.Stats.GetExtension(actualCostExtension).(*ActualCostStats)
+	return s
+}
@@ -0,0 +1,140 @@
+package extension_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/testserver"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+func TestActualCost(t *testing.T) {
+	t.Run("reports estimated and actual cost", func(t *testing.T) {
+		h := testserver.New()
+		h.Use(&extension.ActualCost{})
+		h.AddTransport(&transport.POST{})
+
+		resp := doRequest(h, "POST", "/graphql", `{"query":"{ weighted }"}`)
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		require.Equal(t, `{"data":{"name":"test"},"extensions":{"cost":{"actual":2,"estimated":2}}}`, resp.Body.String())
+
+		resp = doRequest(h, "POST", "/graphql", `{"query":"{ find(id: 1) }"}`)
+		require.Equal(t, `{"data":{"name":"test"},"extensions":{"cost":{"actual":5,"estimated":5}}}`, resp.Body.String())
+	})
+
+	t.Run("counts list items", func(t *testing.T) {
+		schema := gqlparser.MustLoadSchema(&ast.Source{Input: `
+			type Query { users(first: Int): [User] }
+			type User { name: String }
+		`})
+		ext := &extension.ActualCost{}
+		require.NoError(t, ext.Validate(&graphql.ExecutableSchemaMock{
+			SchemaFunc: func() *ast.Schema {
+				return schema
+			},
+		}))
+
+		rc := &graphql.OperationContext{
+			Operation: gqlparser.MustLoadQuery(schema, `{ users(first: 10) { name } }`).Operations[0],
+		}
+		require.Nil(t, ext.MutateOperationContext(context.Background(), rc))
+		ctx := graphql.WithOperationContext(context.Background(), rc)
+		ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+			Field: graphql.CollectedField{Field: rc.Operation.SelectionSet[0].(*ast.Field)},
+		})
+
+		_, err := ext.InterceptField(ctx, func(ctx context.Context) (interface{}, error) {
+			return []string{"a", "b", "c"}, nil
+		})
+		require.NoError(t, err)
+
+		stats := extension.GetActualCostStats(ctx)
+		require.Equal(t, 10, stats.Estimated)
+		require.Equal(t, int64(3), stats.Actual)
+	})
+
+	t.Run("rate limits clients that exhausted their budget", func(t *testing.T) {
+		now := time.Unix(0, 0)
+		graphql.Now = func() time.Time {
+			return now
+		}
+		defer func() {
+			graphql.Now = time.Now
+		}()
+
+		h := testserver.New()
+		h.Use(&extension.ActualCost{
+			Limiter: extension.NewInMemoryRateLimiter(4, 1),
+			Key: func(ctx context.Context) string {
+				return "client"
+			},
+		})
+		h.AddTransport(&transport.POST{})
+		var stats *extension.ActualCostStats
+		h.AroundResponses(func(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+			stats = extension.GetActualCostStats(ctx)
+			return next(ctx)
+		})
+
+		for i := 0; i < 2; i++ {
+			resp := doRequest(h, "POST", "/graphql", `{"query":"{ weighted }"}`)
+			require.Equal(t, `{"data":{"name":"test"},"extensions":{"cost":{"actual":2,"estimated":2}}}`, resp.Body.String())
+			require.Equal(t, "client", stats.Key)
+		}
+
+		resp := doRequest(h, "POST", "/graphql", `{"query":"{ weighted }"}`)
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		require.Equal(t, `{"errors":[{"message":"rate limit exceeded, try again later","extensions":{"code":"RATE_LIMITED"}}],"data":null,"extensions":{"cost":{"actual":0,"estimated":2}}}`, resp.Body.String())
+
+		now = now.Add(2 * time.Second)
+		resp = doRequest(h, "POST", "/graphql", `{"query":"{ weighted }"}`)
+		require.Equal(t, `{"data":{"name":"test"},"extensions":{"cost":{"actual":2,"estimated":2}}}`, resp.Body.String())
+	})
+}
+
+func TestInMemoryRateLimiter(t *testing.T) {
+	now := time.Unix(0, 0)
+	graphql.Now = func() time.Time {
+		return now
+	}
+	defer func() {
+		graphql.Now = time.Now
+	}()
+
+	ctx := context.Background()
+	limiter := extension.NewInMemoryRateLimiter(10, 2)
+
+	require.NoError(t, limiter.Charge(ctx, "a", 25))
+	allowed, err := limiter.Allow(ctx, "a", 1)
+	require.NoError(t, err)
+	require.False(t, allowed)
+
+	allowed, err = limiter.Allow(ctx, "b", 1)
+	require.NoError(t, err)
+	require.True(t, allowed, "buckets are independent")
+
+	now = now.Add(7 * time.Second)
+	allowed, _ = limiter.Allow(ctx, "a", 1)
+	require.False(t, allowed, "refilled to -1")
+
+	now = now.Add(time.Second)
+	allowed, _ = limiter.Allow(ctx, "a", 1)
+	require.True(t, allowed, "refilled to 1")
+
+	allowed, _ = limiter.Allow(ctx, "a", 2)
+	require.False(t, allowed, "not enough tokens for the estimated cost")
+
+	allowed, _ = limiter.Allow(ctx, "b", 50)
+	require.True(t, allowed, "full buckets allow operations estimated above their capacity")
+
+	now = now.Add(4 * time.Second)
+	allowed, _ = limiter.Allow(ctx, "a", 50)
+	require.False(t, allowed, "refilled to 9")
+}
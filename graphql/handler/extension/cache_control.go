@@ -0,0 +1,129 @@
+package extension
+
+import (
+	"context"
+
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// CacheControl computes the cache policy of operations from the @cacheControl hints of the schema:
+//
+//	directive @cacheControl(maxAge: Int, scope: CacheControlScope, inheritMaxAge: Boolean) on FIELD_DEFINITION | OBJECT | INTERFACE | UNION
+//	enum CacheControlScope { PUBLIC PRIVATE }
+//
+// The hint of a field overrides the one of its type. Root fields and fields returning objects, interfaces or unions
+// restrict the max age to DefaultMaxAge unless they have a hint, other fields inherit the max age of their parent.
+// The HTTP transports send the policy of queries without errors in a Cache-Control header, and resolvers can
+// restrict it further with graphql.GetCachePolicy.
+type CacheControl struct {
+	// DefaultMaxAge is the max age of root fields and fields returning composite types without hints, in seconds.
+	// It is 0 by default, so responses can't be cached unless all of these fields have hints.
+	DefaultMaxAge int
+
+	schema *ast.Schema
+}
+
+var _ interface {
+	graphql.OperationInterceptor
+	graphql.FieldInterceptor
+	graphql.HandlerExtension
+} = &CacheControl{}
+
+func (c CacheControl) ExtensionName() string {
+	return "CacheControl"
+}
+
+func (c *CacheControl) Validate(schema graphql.ExecutableSchema) error {
+	c.schema = schema.Schema()
+	return nil
+}
+
+func (c CacheControl) InterceptOperation(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+	// the ResponseCache extension may have attached the policy already, to cache the response for its max age
+	if graphql.GetCachePolicy(ctx) != nil {
+		return next(ctx)
+	}
+	return next(graphql.WithCachePolicy(ctx, graphql.NewCachePolicy()))
+}
+
+func (c CacheControl) InterceptField(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+	policy := graphql.GetCachePolicy(ctx)
+	fc := graphql.GetFieldContext(ctx)
+	if policy == nil || fc == nil || fc.Field.Definition == nil {
+		return next(ctx)
+	}
+
+	maxAge, scope := c.hint(fc)
+	if maxAge != nil {
+		policy.RestrictMaxAge(*maxAge)
+	}
+	policy.RestrictScope(scope)
+	return next(ctx)
+}
+
+// hint returns the max age and scope of the field being resolved. A nil max age inherits the one of the parent.
+func (c CacheControl) hint(fc *graphql.FieldContext) (*int, graphql.CacheScope) {
+	var maxAge *int
+	scope := graphql.CacheScopePublic
+	inherit := false
+
+	def := c.schema.Types[fc.Field.Definition.Type.Name()]
+	composite := def != nil && def.IsCompositeType()
+	if composite {
+		maxAge, scope, _ = cacheHint(def.Directives.ForName("cacheControl"), scope)
+	}
+
+	if d := fc.Field.Definition.Directives.ForName("cacheControl"); d != nil {
+		var fieldMaxAge *int
+		fieldMaxAge, scope, inherit = cacheHint(d, scope)
+		if fieldMaxAge != nil {
+			maxAge = fieldMaxAge
+		}
+	}
+
+	if inherit {
+		return nil, scope
+	}
+	if maxAge == nil && (composite || c.isRoot(fc.Object)) {
+		maxAge = &c.DefaultMaxAge
+	}
+	return maxAge, scope
+}
+
+func (c CacheControl) isRoot(typeName string) bool {
+	for _, root := range []*ast.Definition{c.schema.Query, c.schema.Mutation, c.schema.Subscription} {
+		if root != nil && root.Name == typeName {
+			return true
+		}
+	}
+	return false
+}
+
+// cacheHint reads the arguments of a @cacheControl directive, the scope only restricts the given one.
+func cacheHint(d *ast.Directive, scope graphql.CacheScope) (maxAge *int, _ graphql.CacheScope, inherit bool) {
+	if d == nil {
+		return nil, scope, false
+	}
+
+	if arg := d.Arguments.ForName("maxAge"); arg != nil {
+		if v, err := arg.Value.Value(nil); err == nil {
+			if n, ok := v.(int64); ok {
+				age := int(n)
+				maxAge = &age
+			}
+		}
+	}
+	if arg := d.Arguments.ForName("scope"); arg != nil {
+		if v, err := arg.Value.Value(nil); err == nil && v == string(graphql.CacheScopePrivate) {
+			scope = graphql.CacheScopePrivate
+		}
+	}
+	if arg := d.Arguments.ForName("inheritMaxAge"); arg != nil {
+		if v, err := arg.Value.Value(nil); err == nil {
+			inherit, _ = v.(bool)
+		}
+	}
+	return maxAge, scope, inherit
+}
@@ -0,0 +1,92 @@
+package extension
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/99designs/gqlgen/complexity"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+const errCostLimit = "COST_LIMIT_EXCEEDED"
+
+// CostLimit allows you to define a limit on the static cost of operations, as estimated by complexity.CalculateCost
+// from the @cost and @listSize directives of the schema.
+//
+// If a query is submitted that exceeds the limit, it is rejected with a COST_LIMIT_EXCEEDED error.
+type CostLimit struct {
+	Func func(ctx context.Context, rc *graphql.OperationContext) int
+
+	es graphql.ExecutableSchema
+}
+
+var _ interface {
+	graphql.OperationContextMutator
+	graphql.HandlerExtension
+} = &CostLimit{}
+
+const costExtension = "CostLimit"
+
+type CostStats struct {
+	// The estimated cost of this request
+	Cost int
+
+	// The cost limit for this request returned by the extension func
+	CostLimit int
+
+	// The estimated cost of every field selected by this request
+	Fields []complexity.FieldCost
+}
+
+// FixedCostLimit sets a cost limit that does not change
+func FixedCostLimit(limit int) *CostLimit {
+	return &CostLimit{
+		Func: func(ctx context.Context, rc *graphql.OperationContext) int {
+			return limit
+		},
+	}
+}
+
+func (c CostLimit) ExtensionName() string {
+	return costExtension
+}
+
+func (c *CostLimit) Validate(schema graphql.ExecutableSchema) error {
+	if c.Func == nil {
+		return fmt.Errorf("CostLimit func can not be nil")
+	}
+	c.es = schema
+	return nil
+}
+
+func (c CostLimit) MutateOperationContext(ctx context.Context, rc *graphql.OperationContext) *gqlerror.Error {
+	cost := complexity.CalculateCost(c.es, rc.Operation, rc.Variables)
+
+	limit := c.Func(ctx, rc)
+
+	rc.Stats.SetExtension(costExtension, &CostStats{
+		Cost:      cost.Total,
+		CostLimit: limit,
+		Fields:    cost.Fields,
+	})
+
+	if cost.Total > limit {
+		err := gqlerror.Errorf("operation has cost %d, which exceeds the limit of %d", cost.Total, limit)
+		errcode.Set(err, errCostLimit)
+		return err
+	}
+
+	return nil
+}
+
+func GetCostStats(ctx context.Context) *CostStats {
+	rc := graphql.GetOperationContext(ctx)
+	if rc == nil {
+		return nil
+	}
+
+	s, _ := rc.Stats.GetExtension(costExtension).(*CostStats)
+	return s
+}
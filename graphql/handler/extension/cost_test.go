@@ -0,0 +1,68 @@
+package extension_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/99designs/gqlgen/complexity"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/testserver"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+func TestHandlerCost(t *testing.T) {
+	h := testserver.New()
+	h.Use(&extension.CostLimit{
+		Func: func(ctx context.Context, rc *graphql.OperationContext) int {
+			if rc.Operation.Name == "Dynamic" {
+				return 10
+			}
+			return 4
+		},
+	})
+	h.AddTransport(&transport.POST{})
+	var stats *extension.CostStats
+	h.AroundResponses(func(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+		stats = extension.GetCostStats(ctx)
+		return next(ctx)
+	})
+
+	t.Run("below cost limit", func(t *testing.T) {
+		stats = nil
+		resp := doRequest(h, "POST", "/graphql", `{"query":"{ name }"}`)
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		require.Equal(t, `{"data":{"name":"test"}}`, resp.Body.String())
+
+		require.Equal(t, &extension.CostStats{
+			Cost:      0,
+			CostLimit: 4,
+			Fields: []complexity.FieldCost{
+				{Path: ast.Path{ast.PathName("name")}, Coordinate: "Query.name", ListSize: 1, Cost: 0},
+			},
+		}, stats)
+	})
+
+	t.Run("above cost limit", func(t *testing.T) {
+		stats = nil
+		resp := doRequest(h, "POST", "/graphql", `{"query":"{ find(id: 1) }"}`)
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		require.Equal(t, `{"errors":[{"message":"operation has cost 5, which exceeds the limit of 4","extensions":{"code":"COST_LIMIT_EXCEEDED"}}],"data":null}`, resp.Body.String())
+
+		require.Equal(t, 4, stats.CostLimit)
+		require.Equal(t, 5, stats.Cost)
+	})
+
+	t.Run("within dynamic cost limit", func(t *testing.T) {
+		stats = nil
+		resp := doRequest(h, "POST", "/graphql", `{"query":"query Dynamic { find(id: 1) }"}`)
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		require.Equal(t, `{"data":{"name":"test"}}`, resp.Body.String())
+
+		require.Equal(t, 10, stats.CostLimit)
+		require.Equal(t, 5, stats.Cost)
+	})
+}
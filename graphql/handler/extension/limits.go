@@ -0,0 +1,479 @@
+package extension
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"github.com/vektah/gqlparser/v2/lexer"
+)
+
+const (
+	errDepthLimit     = "DEPTH_LIMIT_EXCEEDED"
+	errAliasLimit     = "ALIAS_LIMIT_EXCEEDED"
+	errRootFieldLimit = "ROOT_FIELD_LIMIT_EXCEEDED"
+	errDirectiveLimit = "DIRECTIVE_LIMIT_EXCEEDED"
+	errTokenLimit     = "TOKEN_LIMIT_EXCEEDED"
+)
+
+const (
+	depthExtension     = "DepthLimit"
+	aliasExtension     = "AliasLimit"
+	rootFieldExtension = "RootFieldLimit"
+	directiveExtension = "DirectiveLimit"
+	tokenExtension     = "TokenLimit"
+)
+
+var _ interface {
+	graphql.OperationContextMutator
+	graphql.HandlerExtension
+} = &DepthLimit{}
+
+// DepthLimit allows you to define a limit on how deeply fields can be nested in a query, fragments included.
+// Introspection fields are not counted, so that tools can still fetch the schema.
+type DepthLimit struct {
+	Func func(ctx context.Context, rc *graphql.OperationContext) int
+}
+
+type DepthStats struct {
+	// The depth of the most nested field in this request
+	Depth int
+
+	// The depth limit for this request returned by the extension func
+	DepthLimit int
+}
+
+// FixedDepthLimit sets a depth limit that does not change
+func FixedDepthLimit(limit int) *DepthLimit {
+	return &DepthLimit{
+		Func: func(ctx context.Context, rc *graphql.OperationContext) int {
+			return limit
+		},
+	}
+}
+
+func (d DepthLimit) ExtensionName() string {
+	return depthExtension
+}
+
+func (d *DepthLimit) Validate(schema graphql.ExecutableSchema) error {
+	if d.Func == nil {
+		return fmt.Errorf("DepthLimit func can not be nil")
+	}
+	return nil
+}
+
+func (d DepthLimit) MutateOperationContext(ctx context.Context, rc *graphql.OperationContext) *gqlerror.Error {
+	depth := measureOperation(rc).depth
+	limit := d.Func(ctx, rc)
+
+	rc.Stats.SetExtension(depthExtension, &DepthStats{
+		Depth:      depth,
+		DepthLimit: limit,
+	})
+
+	if depth > limit {
+		err := gqlerror.Errorf("operation has depth %d, which exceeds the limit of %d", depth, limit)
+		errcode.Set(err, errDepthLimit)
+		return err
+	}
+
+	return nil
+}
+
+func GetDepthStats(ctx context.Context) *DepthStats {
+	rc := graphql.GetOperationContext(ctx)
+	if rc == nil {
+		return nil
+	}
+
+	s, _ := rc.Stats.GetExtension(depthExtension).(*DepthStats)
+	return s
+}
+
+var _ interface {
+	graphql.OperationContextMutator
+	graphql.HandlerExtension
+} = &AliasLimit{}
+
+// AliasLimit allows you to define a limit on the number of aliased fields in a query. Fields selected by a fragment
+// are counted every time the fragment is spread.
+type AliasLimit struct {
+	Func func(ctx context.Context, rc *graphql.OperationContext) int
+}
+
+type AliasStats struct {
+	// The number of aliased fields in this request
+	Aliases int
+
+	// The alias limit for this request returned by the extension func
+	AliasLimit int
+}
+
+// FixedAliasLimit sets an alias limit that does not change
+func FixedAliasLimit(limit int) *AliasLimit {
+	return &AliasLimit{
+		Func: func(ctx context.Context, rc *graphql.OperationContext) int {
+			return limit
+		},
+	}
+}
+
+func (a AliasLimit) ExtensionName() string {
+	return aliasExtension
+}
+
+func (a *AliasLimit) Validate(schema graphql.ExecutableSchema) error {
+	if a.Func == nil {
+		return fmt.Errorf("AliasLimit func can not be nil")
+	}
+	return nil
+}
+
+func (a AliasLimit) MutateOperationContext(ctx context.Context, rc *graphql.OperationContext) *gqlerror.Error {
+	aliases := measureOperation(rc).aliases
+	limit := a.Func(ctx, rc)
+
+	rc.Stats.SetExtension(aliasExtension, &AliasStats{
+		Aliases:    aliases,
+		AliasLimit: limit,
+	})
+
+	if aliases > limit {
+		err := gqlerror.Errorf("operation has %d aliases, which exceeds the limit of %d", aliases, limit)
+		errcode.Set(err, errAliasLimit)
+		return err
+	}
+
+	return nil
+}
+
+func GetAliasStats(ctx context.Context) *AliasStats {
+	rc := graphql.GetOperationContext(ctx)
+	if rc == nil {
+		return nil
+	}
+
+	s, _ := rc.Stats.GetExtension(aliasExtension).(*AliasStats)
+	return s
+}
+
+var _ interface {
+	graphql.OperationContextMutator
+	graphql.HandlerExtension
+} = &RootFieldLimit{}
+
+// RootFieldLimit allows you to define a limit on the number of fields selected at the root of an operation, fragments
+// included.
+type RootFieldLimit struct {
+	Func func(ctx context.Context, rc *graphql.OperationContext) int
+}
+
+type RootFieldStats struct {
+	// The number of root fields in this request
+	RootFields int
+
+	// The root field limit for this request returned by the extension func
+	RootFieldLimit int
+}
+
+// FixedRootFieldLimit sets a root field limit that does not change
+func FixedRootFieldLimit(limit int) *RootFieldLimit {
+	return &RootFieldLimit{
+		Func: func(ctx context.Context, rc *graphql.OperationContext) int {
+			return limit
+		},
+	}
+}
+
+func (r RootFieldLimit) ExtensionName() string {
+	return rootFieldExtension
+}
+
+func (r *RootFieldLimit) Validate(schema graphql.ExecutableSchema) error {
+	if r.Func == nil {
+		return fmt.Errorf("RootFieldLimit func can not be nil")
+	}
+	return nil
+}
+
+func (r RootFieldLimit) MutateOperationContext(ctx context.Context, rc *graphql.OperationContext) *gqlerror.Error {
+	rootFields := measureOperation(rc).fields
+	limit := r.Func(ctx, rc)
+
+	rc.Stats.SetExtension(rootFieldExtension, &RootFieldStats{
+		RootFields:     rootFields,
+		RootFieldLimit: limit,
+	})
+
+	if rootFields > limit {
+		err := gqlerror.Errorf("operation has %d root fields, which exceeds the limit of %d", rootFields, limit)
+		errcode.Set(err, errRootFieldLimit)
+		return err
+	}
+
+	return nil
+}
+
+func GetRootFieldStats(ctx context.Context) *RootFieldStats {
+	rc := graphql.GetOperationContext(ctx)
+	if rc == nil {
+		return nil
+	}
+
+	s, _ := rc.Stats.GetExtension(rootFieldExtension).(*RootFieldStats)
+	return s
+}
+
+var _ interface {
+	graphql.OperationContextMutator
+	graphql.HandlerExtension
+} = &DirectiveLimit{}
+
+// DirectiveLimit allows you to define a limit on the number of directives applied to a single field.
+type DirectiveLimit struct {
+	Func func(ctx context.Context, rc *graphql.OperationContext) int
+}
+
+type DirectiveStats struct {
+	// The highest number of directives applied to a field in this request
+	Directives int
+
+	// The directive limit for this request returned by the extension func
+	DirectiveLimit int
+}
+
+// FixedDirectiveLimit sets a directive limit that does not change
+func FixedDirectiveLimit(limit int) *DirectiveLimit {
+	return &DirectiveLimit{
+		Func: func(ctx context.Context, rc *graphql.OperationContext) int {
+			return limit
+		},
+	}
+}
+
+func (d DirectiveLimit) ExtensionName() string {
+	return directiveExtension
+}
+
+func (d *DirectiveLimit) Validate(schema graphql.ExecutableSchema) error {
+	if d.Func == nil {
+		return fmt.Errorf("DirectiveLimit func can not be nil")
+	}
+	return nil
+}
+
+func (d DirectiveLimit) MutateOperationContext(ctx context.Context, rc *graphql.OperationContext) *gqlerror.Error {
+	directives := measureOperation(rc).directives
+	limit := d.Func(ctx, rc)
+
+	rc.Stats.SetExtension(directiveExtension, &DirectiveStats{
+		Directives:     directives,
+		DirectiveLimit: limit,
+	})
+
+	if directives > limit {
+		err := gqlerror.Errorf("operation has a field with %d directives, which exceeds the limit of %d", directives, limit)
+		errcode.Set(err, errDirectiveLimit)
+		return err
+	}
+
+	return nil
+}
+
+func GetDirectiveStats(ctx context.Context) *DirectiveStats {
+	rc := graphql.GetOperationContext(ctx)
+	if rc == nil {
+		return nil
+	}
+
+	s, _ := rc.Stats.GetExtension(directiveExtension).(*DirectiveStats)
+	return s
+}
+
+var _ interface {
+	graphql.OperationParameterMutator
+	graphql.HandlerExtension
+} = &TokenLimit{}
+
+// TokenLimit allows you to define a limit on the number of tokens in the query document, comments excluded. The limit
+// is checked before the document is parsed, and the operation context passed to Func only has the fields known by
+// then, like the headers of the request.
+type TokenLimit struct {
+	Func func(ctx context.Context, rc *graphql.OperationContext) int
+}
+
+type TokenStats struct {
+	// The number of tokens in the query document of this request, only counted up to one more than the limit
+	Tokens int
+
+	// The token limit for this request returned by the extension func
+	TokenLimit int
+}
+
+// FixedTokenLimit sets a token limit that does not change
+func FixedTokenLimit(limit int) *TokenLimit {
+	return &TokenLimit{
+		Func: func(ctx context.Context, rc *graphql.OperationContext) int {
+			return limit
+		},
+	}
+}
+
+func (t TokenLimit) ExtensionName() string {
+	return tokenExtension
+}
+
+func (t *TokenLimit) Validate(schema graphql.ExecutableSchema) error {
+	if t.Func == nil {
+		return fmt.Errorf("TokenLimit func can not be nil")
+	}
+	return nil
+}
+
+func (t TokenLimit) MutateOperationParameters(ctx context.Context, params *graphql.RawParams) *gqlerror.Error {
+	rc := graphql.GetOperationContext(ctx)
+	limit := t.Func(ctx, rc)
+	tokens := countTokens(params.Query, limit)
+
+	rc.Stats.SetExtension(tokenExtension, &TokenStats{
+		Tokens:     tokens,
+		TokenLimit: limit,
+	})
+
+	if tokens > limit {
+		err := gqlerror.Errorf("operation has more than %d tokens, which exceeds the limit", limit)
+		errcode.Set(err, errTokenLimit)
+		return err
+	}
+
+	return nil
+}
+
+func GetTokenStats(ctx context.Context) *TokenStats {
+	rc := graphql.GetOperationContext(ctx)
+	if rc == nil {
+		return nil
+	}
+
+	s, _ := rc.Stats.GetExtension(tokenExtension).(*TokenStats)
+	return s
+}
+
+// countTokens counts the tokens of query, up to one more than limit: there is no need to read the rest of documents
+// over the limit.
+func countTokens(query string, limit int) int {
+	l := lexer.New(&ast.Source{Input: query})
+	count := 0
+	for {
+		tok, err := l.ReadToken()
+		if err != nil || tok.Kind == lexer.EOF || count > limit {
+			return count
+		}
+		if tok.Kind != lexer.Comment {
+			count++
+		}
+	}
+}
+
+// selectionMeasures are the measures of a selection set used by the limit extensions.
+type selectionMeasures struct {
+	// depth of the most nested field
+	depth int
+	// number of fields selected directly in the selection set, including the fields of fragments
+	fields int
+	// number of aliased fields in the selection set and all of its children
+	aliases int
+	// highest number of directives applied to a field
+	directives int
+}
+
+// measureOperation measures the operation of rc. Each fragment is only measured once, so that documents spreading
+// the same fragments many times can not make measuring them expensive.
+func measureOperation(rc *graphql.OperationContext) selectionMeasures {
+	op := rc.Doc.Operations.ForName(rc.OperationName)
+	m := &selectionMeasurer{
+		doc:       rc.Doc,
+		fragments: map[string]selectionMeasures{},
+		visiting:  map[string]bool{},
+	}
+	return m.measure(op.SelectionSet)
+}
+
+type selectionMeasurer struct {
+	doc       *ast.QueryDocument
+	fragments map[string]selectionMeasures
+	visiting  map[string]bool
+}
+
+func (m *selectionMeasurer) measure(sel ast.SelectionSet) selectionMeasures {
+	var measures selectionMeasures
+	add := func(child selectionMeasures) {
+		measures.depth = maxInt(measures.depth, child.depth)
+		measures.fields = saturatingAdd(measures.fields, child.fields)
+		measures.aliases = saturatingAdd(measures.aliases, child.aliases)
+		measures.directives = maxInt(measures.directives, child.directives)
+	}
+
+	for _, s := range sel {
+		switch s := s.(type) {
+		case *ast.Field:
+			measures.fields = saturatingAdd(measures.fields, 1)
+			measures.directives = maxInt(measures.directives, len(s.Directives))
+			if s.Alias != "" && s.Alias != s.Name {
+				measures.aliases = saturatingAdd(measures.aliases, 1)
+			}
+			if strings.HasPrefix(s.Name, "__") {
+				continue
+			}
+
+			children := m.measure(s.SelectionSet)
+			measures.depth = maxInt(measures.depth, children.depth+1)
+			measures.aliases = saturatingAdd(measures.aliases, children.aliases)
+			measures.directives = maxInt(measures.directives, children.directives)
+		case *ast.InlineFragment:
+			add(m.measure(s.SelectionSet))
+		case *ast.FragmentSpread:
+			add(m.measureFragment(s.Name))
+		}
+	}
+
+	return measures
+}
+
+func (m *selectionMeasurer) measureFragment(name string) selectionMeasures {
+	if measures, ok := m.fragments[name]; ok {
+		return measures
+	}
+
+	fragment := m.doc.Fragments.ForName(name)
+	if fragment == nil || m.visiting[name] {
+		return selectionMeasures{}
+	}
+
+	m.visiting[name] = true
+	measures := m.measure(fragment.SelectionSet)
+	m.visiting[name] = false
+
+	m.fragments[name] = measures
+	return measures
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func saturatingAdd(a, b int) int {
+	if a > math.MaxInt32-b {
+		return math.MaxInt32
+	}
+	return a + b
+}
@@ -0,0 +1,146 @@
+package extension_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/testserver"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+const fragmentBomb = `
+	query {
+		a: user { ...F2 }
+		b: user { friends { ...F2 } }
+		c: user @include(if: true) @skip(if: false) { name }
+	}
+	fragment F2 on User { f1: friends { ...F1 } f2: friends { ...F1 } }
+	fragment F1 on User { n1: name n2: name ...on User { id } }
+`
+
+func TestLimits(t *testing.T) {
+	tests := []struct {
+		name  string
+		limit interface {
+			graphql.OperationContextMutator
+			graphql.HandlerExtension
+		}
+		stats    func(ctx context.Context) interface{}
+		expected interface{}
+		message  string
+	}{
+		{
+			name:     "depth",
+			limit:    extension.FixedDepthLimit(3),
+			stats:    func(ctx context.Context) interface{} { return extension.GetDepthStats(ctx) },
+			expected: &extension.DepthStats{Depth: 4, DepthLimit: 3},
+			message:  `{"message":"operation has depth 4, which exceeds the limit of 3","extensions":{"code":"DEPTH_LIMIT_EXCEEDED"}}`,
+		},
+		{
+			name:     "aliases",
+			limit:    extension.FixedAliasLimit(11),
+			stats:    func(ctx context.Context) interface{} { return extension.GetAliasStats(ctx) },
+			expected: &extension.AliasStats{Aliases: 15, AliasLimit: 11},
+			message:  `{"message":"operation has 15 aliases, which exceeds the limit of 11","extensions":{"code":"ALIAS_LIMIT_EXCEEDED"}}`,
+		},
+		{
+			name:     "root fields",
+			limit:    extension.FixedRootFieldLimit(2),
+			stats:    func(ctx context.Context) interface{} { return extension.GetRootFieldStats(ctx) },
+			expected: &extension.RootFieldStats{RootFields: 3, RootFieldLimit: 2},
+			message:  `{"message":"operation has 3 root fields, which exceeds the limit of 2","extensions":{"code":"ROOT_FIELD_LIMIT_EXCEEDED"}}`,
+		},
+		{
+			name:     "directives",
+			limit:    extension.FixedDirectiveLimit(1),
+			stats:    func(ctx context.Context) interface{} { return extension.GetDirectiveStats(ctx) },
+			expected: &extension.DirectiveStats{Directives: 2, DirectiveLimit: 1},
+			message:  `{"message":"operation has a field with 2 directives, which exceeds the limit of 1","extensions":{"code":"DIRECTIVE_LIMIT_EXCEEDED"}}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.NoError(t, tc.limit.Validate(nil))
+
+			doc, err := parser.ParseQuery(&ast.Source{Input: fragmentBomb})
+			require.Nil(t, err)
+			rc := &graphql.OperationContext{RawQuery: fragmentBomb, Doc: doc, Operation: doc.Operations[0]}
+			ctx := graphql.WithOperationContext(context.Background(), rc)
+
+			gqlErr := tc.limit.MutateOperationContext(ctx, rc)
+			require.NotNil(t, gqlErr)
+			b, _ := json.Marshal(gqlErr)
+			require.JSONEq(t, tc.message, string(b))
+			require.Equal(t, tc.expected, tc.stats(ctx))
+		})
+	}
+}
+
+func TestTokenLimit(t *testing.T) {
+	limit := extension.FixedTokenLimit(50)
+	require.NoError(t, limit.Validate(nil))
+
+	t.Run("below the limit", func(t *testing.T) {
+		rc := &graphql.OperationContext{}
+		ctx := graphql.WithOperationContext(context.Background(), rc)
+
+		require.Nil(t, limit.MutateOperationParameters(ctx, &graphql.RawParams{Query: "{ user { name } } # comment"}))
+		require.Equal(t, &extension.TokenStats{Tokens: 6, TokenLimit: 50}, extension.GetTokenStats(ctx))
+	})
+
+	t.Run("above the limit", func(t *testing.T) {
+		rc := &graphql.OperationContext{}
+		ctx := graphql.WithOperationContext(context.Background(), rc)
+
+		gqlErr := limit.MutateOperationParameters(ctx, &graphql.RawParams{Query: fragmentBomb})
+		require.NotNil(t, gqlErr)
+		b, _ := json.Marshal(gqlErr)
+		require.JSONEq(t, `{"message":"operation has more than 50 tokens, which exceeds the limit","extensions":{"code":"TOKEN_LIMIT_EXCEEDED"}}`, string(b))
+		require.Equal(t, &extension.TokenStats{Tokens: 51, TokenLimit: 50}, extension.GetTokenStats(ctx))
+	})
+
+	t.Run("is checked before parsing", func(t *testing.T) {
+		h := testserver.New()
+		h.Use(extension.FixedTokenLimit(10))
+		h.AddTransport(&transport.POST{})
+
+		resp := doRequest(h, "POST", "/graphql", `{"query":"{ name name name name name name name name name name name name } }"}`)
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		require.Equal(t, `{"errors":[{"message":"operation has more than 10 tokens, which exceeds the limit","extensions":{"code":"TOKEN_LIMIT_EXCEEDED"}}],"data":null}`, resp.Body.String())
+	})
+}
+
+func TestHandlerLimits(t *testing.T) {
+	h := testserver.New()
+	h.Use(extension.FixedDepthLimit(1))
+	h.Use(extension.FixedAliasLimit(1))
+	h.Use(extension.FixedRootFieldLimit(2))
+	h.Use(extension.FixedDirectiveLimit(1))
+	h.Use(extension.FixedTokenLimit(10))
+	h.AddTransport(&transport.POST{})
+
+	t.Run("below limits", func(t *testing.T) {
+		resp := doRequest(h, "POST", "/graphql", `{"query":"{ name: name __typename }"}`)
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		require.Equal(t, `{"data":{"name":"test"}}`, resp.Body.String())
+	})
+
+	t.Run("above limits", func(t *testing.T) {
+		resp := doRequest(h, "POST", "/graphql", `{"query":"{ a: name b: name }"}`)
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		require.Equal(t, `{"errors":[{"message":"operation has 2 aliases, which exceeds the limit of 1","extensions":{"code":"ALIAS_LIMIT_EXCEEDED"}}],"data":null}`, resp.Body.String())
+	})
+
+	t.Run("introspection is not counted in depth", func(t *testing.T) {
+		resp := doRequest(h, "POST", "/graphql", `{"query":"{ __schema { types { name } } }"}`)
+		require.NotContains(t, resp.Body.String(), "DEPTH_LIMIT_EXCEEDED")
+	})
+}
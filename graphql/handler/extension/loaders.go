@@ -0,0 +1,37 @@
+package extension
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// Loaders gives every operation a fresh set of loaders, so their caches only live as long as the operation.
+// Resolvers get them with graphql.GetLoader.
+type Loaders struct {
+	// Register adds the loaders of an operation with graphql.AddLoader
+	Register func(ctx context.Context, loaders *graphql.Loaders)
+}
+
+var _ interface {
+	graphql.OperationInterceptor
+	graphql.HandlerExtension
+} = Loaders{}
+
+func (l Loaders) ExtensionName() string {
+	return "Loaders"
+}
+
+func (l Loaders) Validate(schema graphql.ExecutableSchema) error {
+	if l.Register == nil {
+		return fmt.Errorf("Loaders register func can not be nil")
+	}
+	return nil
+}
+
+func (l Loaders) InterceptOperation(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+	loaders := graphql.NewLoaders()
+	l.Register(ctx, loaders)
+	return next(graphql.WithLoaders(ctx, loaders))
+}
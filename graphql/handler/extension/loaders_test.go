@@ -0,0 +1,45 @@
+package extension_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/testserver"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoaders(t *testing.T) {
+	h := testserver.New()
+	h.AddTransport(&transport.POST{})
+	h.Use(extension.Loaders{Register: func(ctx context.Context, loaders *graphql.Loaders) {
+		graphql.AddLoader(loaders, "name", graphql.LoaderConfig[int, string]{
+			Fetch: func(ctx context.Context, keys []int) ([]string, []error) {
+				return []string{"test"}, nil
+			},
+		})
+	}})
+
+	var seen []*graphql.Loader[int, string]
+	h.AroundResponses(func(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+		l := graphql.GetLoader[int, string](ctx, "name")
+		require.Same(t, l, graphql.GetLoader[int, string](ctx, "name"))
+		seen = append(seen, l)
+		return next(ctx)
+	})
+
+	for i := 0; i < 2; i++ {
+		resp := doRequest(h, "POST", "/graphql", `{"query":"{ name }"}`)
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+	}
+
+	require.Len(t, seen, 2)
+	require.NotSame(t, seen[0], seen[1], "every operation has its own loaders")
+}
+
+func TestLoadersValidate(t *testing.T) {
+	require.EqualError(t, extension.Loaders{}.Validate(nil), "Loaders register func can not be nil")
+}
@@ -0,0 +1,90 @@
+package extension
+
+import (
+	"context"
+	"math"
+	"sync"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// RateLimiter keeps a token bucket for every client. Clients can run operations as long as they have enough tokens left
+// for their estimated cost, and are charged for the actual cost of every operation they run.
+type RateLimiter interface {
+	// Allow reports whether the client identified by key can run an operation with the estimated cost.
+	Allow(ctx context.Context, key string, estimated int) (bool, error)
+
+	// Charge takes cost tokens from the bucket of the client identified by key.
+	Charge(ctx context.Context, key string, cost int) error
+}
+
+// InMemoryRateLimiter is a RateLimiter that keeps its buckets in memory, so it is only suitable for a single server.
+// Clients are allowed to run operations while their bucket holds the estimated cost, or is full for operations estimated
+// to cost more than its capacity. Operations can still cost more than estimated, which takes the bucket below zero, and
+// the client has to wait for it to be refilled.
+type InMemoryRateLimiter struct {
+	capacity float64
+	rate     float64
+
+	mu      sync.Mutex
+	buckets map[string]*tokenBucket
+}
+
+type tokenBucket struct {
+	tokens float64
+	last   int64
+}
+
+var _ RateLimiter = &InMemoryRateLimiter{}
+
+// NewInMemoryRateLimiter creates a rate limiter with buckets holding up to capacity tokens, which are refilled with
+// perSecond tokens every second.
+func NewInMemoryRateLimiter(capacity int, perSecond float64) *InMemoryRateLimiter {
+	return &InMemoryRateLimiter{
+		capacity: float64(capacity),
+		rate:     perSecond,
+		buckets:  map[string]*tokenBucket{},
+	}
+}
+
+func (l *InMemoryRateLimiter) Allow(ctx context.Context, key string, estimated int) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	bucket := l.refill(key)
+	if bucket == nil {
+		return l.capacity > 0, nil
+	}
+	return bucket.tokens > 0 && bucket.tokens >= math.Min(float64(estimated), l.capacity), nil
+}
+
+func (l *InMemoryRateLimiter) Charge(ctx context.Context, key string, cost int) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	bucket := l.refill(key)
+	if bucket == nil {
+		bucket = &tokenBucket{tokens: l.capacity, last: graphql.Now().UnixNano()}
+		l.buckets[key] = bucket
+	}
+	bucket.tokens -= float64(cost)
+	return nil
+}
+
+// refill adds the tokens a bucket earned since it was last used. Buckets are only kept until they are full again, nil
+// is returned for full buckets.
+func (l *InMemoryRateLimiter) refill(key string) *tokenBucket {
+	bucket, ok := l.buckets[key]
+	if !ok {
+		return nil
+	}
+
+	now := graphql.Now().UnixNano()
+	bucket.tokens = math.Min(l.capacity, bucket.tokens+float64(now-bucket.last)/1e9*l.rate)
+	bucket.last = now
+	if bucket.tokens >= l.capacity {
+		delete(l.buckets, key)
+		return nil
+	}
+	return bucket
+}
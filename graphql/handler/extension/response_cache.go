@@ -0,0 +1,436 @@
+package extension
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/formatter"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// ResponseStore keeps the responses cached by ResponseCache. Entries expire at their expiry time, and are tagged with
+// the types and objects they contain so that they can be invalidated when those change.
+type ResponseStore interface {
+	// Get looks up the response stored with key, unless it expired.
+	Get(ctx context.Context, key string) (*CachedResponse, bool)
+
+	// Add stores a response until it expires.
+	Add(ctx context.Context, key string, response *CachedResponse, tags []string)
+
+	// Invalidate removes the responses tagged with any of tags.
+	Invalidate(ctx context.Context, tags ...string)
+}
+
+// CachedResponse is a response kept by a ResponseStore, along with the cache policy it was cached with, so that the
+// responses served from the cache keep their policy, minus the time they spent in the cache.
+type CachedResponse struct {
+	Response *graphql.Response
+
+	// Cached is when the response was cached.
+	Cached time.Time
+
+	// Expires is when the response leaves the cache.
+	Expires time.Time
+
+	// MaxAge is the max age of the policy of the response in seconds, nil when no field restricted it.
+	MaxAge *int
+
+	// Scope is the scope of the policy of the response.
+	Scope graphql.CacheScope
+}
+
+// ResponseCache caches the responses of queries, so that running the same query again with the same variables skips
+// its execution until the response expires. Responses are keyed on the normalized document, the operation name, the
+// variables and the scope of the client.
+//
+// When the CacheControl extension is used the responses are only cached for their max age, and private responses are
+// only cached when the scope identifies the client. Responses with errors and incremental responses are never cached.
+//
+// Responses are tagged with the names of the object types they contain, and with Type:id for the objects that have
+// an id. Mutations invalidate the responses containing the objects they return, and Invalidate can be called when data
+// changes some other way.
+type ResponseCache struct {
+	Store ResponseStore
+
+	// TTL is how long responses are cached at most.
+	TTL time.Duration
+
+	// Scope returns the part of the cache the client can use, the id of the user for example. Responses are only
+	// shared between clients with the same scope, and private responses are only cached when the scope is not empty.
+	Scope func(ctx context.Context) string
+
+	// IDField is the name of the field identifying objects in tags, id by default.
+	IDField string
+}
+
+type ResponseCacheStats struct {
+	// Key of the response in the store
+	Key string
+
+	// Hit is true if the response came from the cache
+	Hit bool
+}
+
+const responseCacheExtension = "ResponseCache"
+
+type responseCacheTagsKey struct{}
+
+// responseCacheTags collects the tags of the objects resolved by an operation
+type responseCacheTags struct {
+	mu   sync.Mutex
+	tags map[string]struct{}
+}
+
+var _ interface {
+	graphql.OperationInterceptor
+	graphql.FieldInterceptor
+	graphql.HandlerExtension
+} = ResponseCache{}
+
+func (c ResponseCache) ExtensionName() string {
+	return responseCacheExtension
+}
+
+func (c ResponseCache) Validate(schema graphql.ExecutableSchema) error {
+	if c.Store == nil {
+		return fmt.Errorf("ResponseCache.Store can not be nil")
+	}
+	if c.TTL <= 0 {
+		return fmt.Errorf("ResponseCache.TTL must be positive")
+	}
+	return nil
+}
+
+// Invalidate removes the cached responses containing any of the types or objects tagged, for example Post or Post:1.
+func (c ResponseCache) Invalidate(ctx context.Context, tags ...string) {
+	c.Store.Invalidate(ctx, tags...)
+}
+
+func (c ResponseCache) InterceptOperation(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+	rc := graphql.GetOperationContext(ctx)
+	if rc.Operation == nil || rc.Operation.Operation == ast.Subscription {
+		return next(ctx)
+	}
+
+	tags := &responseCacheTags{tags: map[string]struct{}{}}
+	ctx = context.WithValue(ctx, responseCacheTagsKey{}, tags)
+
+	if rc.Operation.Operation == ast.Mutation {
+		responses := next(ctx)
+		return func(ctx context.Context) *graphql.Response {
+			resp := responses(ctx)
+			if resp != nil {
+				c.Store.Invalidate(ctx, tags.objects()...)
+			}
+			return resp
+		}
+	}
+
+	key, err := c.key(ctx, rc)
+	if err != nil {
+		return next(ctx)
+	}
+	stats := &ResponseCacheStats{Key: key}
+	rc.Stats.SetExtension(responseCacheExtension, stats)
+
+	// the response is stored, or comes from the store, so its data can't be streamed
+	rc.StreamData = false
+
+	if cached, ok := c.Store.Get(ctx, key); ok {
+		stats.Hit = true
+		return c.hit(ctx, cached, next)
+	}
+
+	policy := graphql.GetCachePolicy(ctx)
+	if policy == nil {
+		policy = graphql.NewCachePolicy()
+		ctx = graphql.WithCachePolicy(ctx, policy)
+	}
+
+	responses := next(ctx)
+	first := true
+	return func(ctx context.Context) *graphql.Response {
+		resp := responses(ctx)
+		if resp == nil || !first {
+			return resp
+		}
+		first = false
+
+		if ttl, ok := c.ttl(ctx, policy); ok && len(resp.Errors) == 0 && resp.HasNext == nil {
+			now := graphql.Now()
+			maxAge, restricted := policy.MaxAge()
+			cached := &CachedResponse{
+				Response: &graphql.Response{Data: resp.Data},
+				Cached:   now,
+				Expires:  now.Add(ttl),
+				Scope:    policy.Scope(),
+			}
+			if restricted {
+				cached.MaxAge = &maxAge
+			}
+			c.Store.Add(ctx, key, cached, tags.all())
+		}
+		setResponseCacheHit(resp, false)
+		return resp
+	}
+}
+
+// hit answers the operation with a cached response, which goes through the response interceptors like the responses
+// of executed operations, with the cache policy of the response minus its age.
+func (c ResponseCache) hit(ctx context.Context, cached *CachedResponse, next graphql.OperationHandler) graphql.ResponseHandler {
+	policy := graphql.GetCachePolicy(ctx)
+	if policy == nil {
+		policy = graphql.NewCachePolicy()
+		ctx = graphql.WithCachePolicy(ctx, policy)
+	}
+	if cached.MaxAge != nil {
+		age := int(graphql.Now().Sub(cached.Cached) / time.Second)
+		policy.RestrictMaxAge(*cached.MaxAge - age)
+	}
+	policy.RestrictScope(cached.Scope)
+
+	responses := next(graphql.WithPrecomputedResponse(ctx, &graphql.Response{Data: cached.Response.Data}))
+	return func(ctx context.Context) *graphql.Response {
+		resp := responses(ctx)
+		if resp != nil {
+			setResponseCacheHit(resp, true)
+		}
+		return resp
+	}
+}
+
+func (c ResponseCache) InterceptField(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+	res, err := next(ctx)
+
+	tags, _ := ctx.Value(responseCacheTagsKey{}).(*responseCacheTags)
+	fc := graphql.GetFieldContext(ctx)
+	if tags == nil || fc == nil || err != nil {
+		return res, err
+	}
+
+	tags.add(fc.Object)
+	if fc.Field.Field != nil && fc.Field.Name == c.idField() {
+		if id, ok := tagID(res); ok {
+			tags.add(fc.Object + ":" + id)
+		}
+	}
+	return res, err
+}
+
+// key hashes the normalized document, so that queries only differing by whitespace or comments share responses
+func (c ResponseCache) key(ctx context.Context, rc *graphql.OperationContext) (string, error) {
+	variables, err := json.Marshal(rc.Variables)
+	if err != nil {
+		return "", err
+	}
+
+	var doc bytes.Buffer
+	formatter.NewFormatter(&doc).FormatQueryDocument(rc.Doc)
+
+	scope := ""
+	if c.Scope != nil {
+		scope = c.Scope(ctx)
+	}
+
+	h := sha256.New()
+	for _, part := range [][]byte{doc.Bytes(), []byte(rc.OperationName), variables, []byte(scope)} {
+		h.Write(part)
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// ttl returns how long the response can be cached according to its cache policy
+func (c ResponseCache) ttl(ctx context.Context, policy *graphql.CachePolicy) (time.Duration, bool) {
+	ttl := c.TTL
+	if maxAge, ok := policy.MaxAge(); ok && time.Duration(maxAge)*time.Second < ttl {
+		ttl = time.Duration(maxAge) * time.Second
+	}
+	if ttl <= 0 {
+		return 0, false
+	}
+	if policy.Scope() == graphql.CacheScopePrivate && (c.Scope == nil || c.Scope(ctx) == "") {
+		return 0, false
+	}
+	return ttl, true
+}
+
+func setResponseCacheHit(resp *graphql.Response, hit bool) {
+	if resp.Extensions == nil {
+		resp.Extensions = map[string]interface{}{}
+	}
+	resp.Extensions["responseCache"] = map[string]interface{}{"hit": hit}
+}
+
+func (c ResponseCache) idField() string {
+	if c.IDField == "" {
+		return "id"
+	}
+	return c.IDField
+}
+
+func GetResponseCacheStats(ctx context.Context) *ResponseCacheStats {
+	rc := graphql.GetOperationContext(ctx)
+	if rc == nil {
+		return nil
+	}
+
+	s, _ := rc.Stats.GetExtension(responseCacheExtension).(*ResponseCacheStats)
+	return s
+}
+
+func (t *responseCacheTags) add(tag string) {
+	t.mu.Lock()
+	t.tags[tag] = struct{}{}
+	t.mu.Unlock()
+}
+
+func (t *responseCacheTags) all() []string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	tags := make([]string, 0, len(t.tags))
+	for tag := range t.tags {
+		tags = append(tags, tag)
+	}
+	return tags
+}
+
+// objects returns the Type:id tags, as invalidating whole types after every mutation would empty most of the cache
+func (t *responseCacheTags) objects() []string {
+	var objects []string
+	for _, tag := range t.all() {
+		if strings.Contains(tag, ":") {
+			objects = append(objects, tag)
+		}
+	}
+	return objects
+}
+
+func tagID(v interface{}) (string, bool) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return "", false
+		}
+		rv = rv.Elem()
+	}
+	if !rv.IsValid() {
+		return "", false
+	}
+	return fmt.Sprint(rv.Interface()), true
+}
+
+// InMemoryResponseStore is a ResponseStore that keeps responses in memory, so it is only suitable for a single
+// server. When it is full the responses expiring first are evicted.
+type InMemoryResponseStore struct {
+	size int
+
+	mu      sync.Mutex
+	entries map[string]*responseEntry
+	tags    map[string]map[string]struct{}
+}
+
+type responseEntry struct {
+	response *CachedResponse
+	tags     []string
+}
+
+var _ ResponseStore = &InMemoryResponseStore{}
+
+// NewInMemoryResponseStore creates a store holding up to size responses.
+func NewInMemoryResponseStore(size int) *InMemoryResponseStore {
+	if size <= 0 {
+		panic("the size of the response store must be positive")
+	}
+	return &InMemoryResponseStore{
+		size:    size,
+		entries: map[string]*responseEntry{},
+		tags:    map[string]map[string]struct{}{},
+	}
+}
+
+func (s *InMemoryResponseStore) Get(ctx context.Context, key string) (*CachedResponse, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, ok := s.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if !graphql.Now().Before(entry.response.Expires) {
+		s.remove(key)
+		return nil, false
+	}
+	return entry.response, true
+}
+
+func (s *InMemoryResponseStore) Add(ctx context.Context, key string, response *CachedResponse, tags []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.remove(key)
+	if len(s.entries) >= s.size {
+		s.evict()
+	}
+
+	s.entries[key] = &responseEntry{response: response, tags: tags}
+	for _, tag := range tags {
+		if s.tags[tag] == nil {
+			s.tags[tag] = map[string]struct{}{}
+		}
+		s.tags[tag][key] = struct{}{}
+	}
+}
+
+func (s *InMemoryResponseStore) Invalidate(ctx context.Context, tags ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, tag := range tags {
+		for key := range s.tags[tag] {
+			s.remove(key)
+		}
+	}
+}
+
+// evict removes the expired responses, or the one expiring first when none did
+func (s *InMemoryResponseStore) evict() {
+	now := graphql.Now()
+	first := ""
+	for key, entry := range s.entries {
+		if !now.Before(entry.response.Expires) {
+			s.remove(key)
+			continue
+		}
+		if first == "" || entry.response.Expires.Before(s.entries[first].response.Expires) {
+			first = key
+		}
+	}
+	if len(s.entries) >= s.size && first != "" {
+		s.remove(first)
+	}
+}
+
+func (s *InMemoryResponseStore) remove(key string) {
+	entry, ok := s.entries[key]
+	if !ok {
+		return
+	}
+	delete(s.entries, key)
+	for _, tag := range entry.tags {
+		delete(s.tags[tag], key)
+		if len(s.tags[tag]) == 0 {
+			delete(s.tags, tag)
+		}
+	}
+}
@@ -0,0 +1,139 @@
+package extension_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/testserver"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResponseCache(t *testing.T) {
+	store := extension.NewInMemoryResponseStore(10)
+	h := testserver.New()
+	h.AddTransport(&transport.POST{})
+	h.Use(extension.ResponseCache{Store: store, TTL: time.Minute})
+
+	var stats []*extension.ResponseCacheStats
+	h.AroundResponses(func(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+		stats = append(stats, extension.GetResponseCacheStats(ctx))
+		return next(ctx)
+	})
+
+	resp := doRequest(h, "POST", "/graphql", `{"query":"{ name }"}`)
+	require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+	require.JSONEq(t, `{"data":{"name":"test"},"extensions":{"responseCache":{"hit":false}}}`, resp.Body.String())
+
+	resp = doRequest(h, "POST", "/graphql", `{"query":"# the same query\n{\n  name\n}"}`)
+	require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+	require.JSONEq(t, `{"data":{"name":"test"},"extensions":{"responseCache":{"hit":true}}}`, resp.Body.String())
+
+	require.Len(t, stats, 2, "the cached response goes through the response interceptors")
+	require.False(t, stats[0].Hit)
+	require.True(t, stats[1].Hit)
+
+	extension.ResponseCache{Store: store}.Invalidate(context.Background(), "Query")
+	resp = doRequest(h, "POST", "/graphql", `{"query":"{ name }"}`)
+	require.JSONEq(t, `{"data":{"name":"test"},"extensions":{"responseCache":{"hit":false}}}`, resp.Body.String())
+}
+
+func TestResponseCacheControl(t *testing.T) {
+	now := time.Unix(0, 0)
+	graphql.Now = func() time.Time {
+		return now
+	}
+	defer func() {
+		graphql.Now = time.Now
+	}()
+
+	h := testserver.New()
+	h.AddTransport(&transport.POST{})
+	h.Use(extension.ResponseCache{Store: extension.NewInMemoryResponseStore(10), TTL: time.Minute})
+	h.Use(&extension.CacheControl{})
+
+	var ttl time.Duration
+	h.AroundResponses(func(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+		resp := next(ctx)
+		maxAge, _ := graphql.GetCachePolicy(ctx).MaxAge()
+		ttl = time.Duration(maxAge) * time.Second
+		return resp
+	})
+
+	resp := doRequest(h, "POST", "/graphql", `{"query":"{ name }"}`)
+	require.Equal(t, "max-age=60, public", resp.Header().Get("Cache-Control"))
+	require.Equal(t, time.Minute, ttl)
+
+	now = now.Add(20 * time.Second)
+	resp = doRequest(h, "POST", "/graphql", `{"query":"{ name }"}`)
+	require.JSONEq(t, `{"data":{"name":"test"},"extensions":{"responseCache":{"hit":true}}}`, resp.Body.String())
+	require.Equal(t, "max-age=40, public", resp.Header().Get("Cache-Control"), "hits can be cached for the time they have left")
+	require.Equal(t, 40*time.Second, ttl)
+}
+
+func TestResponseCacheValidate(t *testing.T) {
+	require.EqualError(t, extension.ResponseCache{TTL: time.Minute}.Validate(nil), "ResponseCache.Store can not be nil")
+	require.EqualError(t, extension.ResponseCache{Store: extension.NewInMemoryResponseStore(1)}.Validate(nil), "ResponseCache.TTL must be positive")
+}
+
+func TestInMemoryResponseStore(t *testing.T) {
+	now := time.Unix(0, 0)
+	graphql.Now = func() time.Time {
+		return now
+	}
+	defer func() {
+		graphql.Now = time.Now
+	}()
+
+	ctx := context.Background()
+	response := func(data string, ttl time.Duration) *extension.CachedResponse {
+		return &extension.CachedResponse{Response: &graphql.Response{Data: []byte(data)}, Expires: now.Add(ttl)}
+	}
+
+	t.Run("expires responses", func(t *testing.T) {
+		store := extension.NewInMemoryResponseStore(10)
+		store.Add(ctx, "a", response(`1`, time.Second), nil)
+
+		resp, ok := store.Get(ctx, "a")
+		require.True(t, ok)
+		require.Equal(t, `1`, string(resp.Response.Data))
+
+		now = now.Add(time.Second)
+		_, ok = store.Get(ctx, "a")
+		require.False(t, ok)
+	})
+
+	t.Run("invalidates tags", func(t *testing.T) {
+		store := extension.NewInMemoryResponseStore(10)
+		store.Add(ctx, "a", response(`1`, time.Second), []string{"Post", "Post:1"})
+		store.Add(ctx, "b", response(`2`, time.Second), []string{"Post", "Post:2"})
+
+		store.Invalidate(ctx, "Post:1")
+		_, ok := store.Get(ctx, "a")
+		require.False(t, ok)
+		_, ok = store.Get(ctx, "b")
+		require.True(t, ok)
+
+		store.Invalidate(ctx, "Post")
+		_, ok = store.Get(ctx, "b")
+		require.False(t, ok)
+	})
+
+	t.Run("evicts the responses expiring first when full", func(t *testing.T) {
+		store := extension.NewInMemoryResponseStore(2)
+		store.Add(ctx, "a", response(`1`, 2*time.Second), nil)
+		store.Add(ctx, "b", response(`2`, time.Second), nil)
+		store.Add(ctx, "c", response(`3`, 3*time.Second), nil)
+
+		_, ok := store.Get(ctx, "a")
+		require.True(t, ok)
+		_, ok = store.Get(ctx, "b")
+		require.False(t, ok)
+		_, ok = store.Get(ctx, "c")
+		require.True(t, ok)
+	})
+}
@@ -0,0 +1,222 @@
+package extension
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/mitchellh/mapstructure"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+const (
+	errPersistedQueryNotInList     = "PersistedQueryNotInList"
+	errPersistedQueryNotInListCode = "PERSISTED_QUERY_NOT_IN_LIST"
+	errQueryNotInSafelistCode      = "QUERY_NOT_IN_SAFELIST"
+)
+
+// TrustedDocuments only allows clients to run the operations listed in a manifest of trusted documents, which is
+// usually generated when building the clients. Clients send the sha256 hash of a document in the persistedQuery
+// extension, the same way they do with AutomaticPersistedQuery, but documents that are not in the manifest are never
+// registered. It should not be used along with AutomaticPersistedQuery.
+//
+// Operations sent with their full document are allowed if the document is in the manifest.
+type TrustedDocuments struct {
+	// Documents maps the hash of every trusted document to its body, see LoadTrustedDocuments.
+	Documents map[string]string
+
+	// LogOnly runs operations that are not in the manifest instead of rejecting them. Along with OnUntrusted it can be
+	// used to find which clients still send untrusted operations before enforcing the manifest.
+	LogOnly bool
+
+	// AllowIntrospection allows operations that only query introspection fields, so that development tools keep
+	// working. Introspection still needs to be enabled on the server.
+	AllowIntrospection bool
+
+	// OnUntrusted is called with every operation that is not in the manifest, whether it is rejected or not.
+	OnUntrusted func(ctx context.Context, query string)
+
+	hashes map[string]string
+}
+
+type TrustedDocumentStats struct {
+	// Hash of the document, empty if the client sent a document that is not in the manifest
+	Hash string
+
+	// Trusted is true if the document was found in the manifest
+	Trusted bool
+}
+
+const trustedDocumentsExtension = "TrustedDocuments"
+
+var _ interface {
+	graphql.OperationParameterMutator
+	graphql.OperationContextMutator
+	graphql.HandlerExtension
+} = &TrustedDocuments{}
+
+func (t TrustedDocuments) ExtensionName() string {
+	return trustedDocumentsExtension
+}
+
+func (t *TrustedDocuments) Validate(schema graphql.ExecutableSchema) error {
+	if t.Documents == nil {
+		return fmt.Errorf("TrustedDocuments.Documents can not be nil")
+	}
+
+	t.hashes = make(map[string]string, len(t.Documents))
+	for hash, document := range t.Documents {
+		t.hashes[document] = hash
+	}
+	return nil
+}
+
+func (t TrustedDocuments) MutateOperationParameters(ctx context.Context, rawParams *graphql.RawParams) *gqlerror.Error {
+	stats := &TrustedDocumentStats{}
+	graphql.GetOperationContext(ctx).Stats.SetExtension(trustedDocumentsExtension, stats)
+
+	if rawParams.Extensions["persistedQuery"] != nil {
+		var extension struct {
+			Sha256 string `mapstructure:"sha256Hash"`
+		}
+		if err := mapstructure.Decode(rawParams.Extensions["persistedQuery"], &extension); err != nil {
+			return gqlerror.Errorf("invalid persisted query extension data")
+		}
+
+		document, ok := t.Documents[extension.Sha256]
+		if rawParams.Query == "" {
+			if !ok {
+				err := gqlerror.Errorf(errPersistedQueryNotInList)
+				errcode.Set(err, errPersistedQueryNotInListCode)
+				return err
+			}
+			rawParams.Query = document
+		}
+		if ok && rawParams.Query == document {
+			stats.Hash = extension.Sha256
+			stats.Trusted = true
+			return nil
+		}
+	} else if hash, ok := t.hashes[rawParams.Query]; ok {
+		stats.Hash = hash
+		stats.Trusted = true
+		return nil
+	}
+
+	if t.AllowIntrospection {
+		// the document needs to be parsed to know whether it only queries introspection fields
+		return nil
+	}
+	return t.untrusted(ctx, rawParams.Query)
+}
+
+func (t TrustedDocuments) MutateOperationContext(ctx context.Context, rc *graphql.OperationContext) *gqlerror.Error {
+	stats, _ := rc.Stats.GetExtension(trustedDocumentsExtension).(*TrustedDocumentStats)
+	if stats == nil || stats.Trusted || !t.AllowIntrospection {
+		return nil
+	}
+
+	if isIntrospectionOnly(rc.Doc, rc.Operation.SelectionSet) {
+		return nil
+	}
+	return t.untrusted(ctx, rc.RawQuery)
+}
+
+func (t TrustedDocuments) untrusted(ctx context.Context, query string) *gqlerror.Error {
+	if t.OnUntrusted != nil {
+		t.OnUntrusted(ctx, query)
+	}
+	if t.LogOnly {
+		return nil
+	}
+
+	err := gqlerror.Errorf("operation is not in the list of trusted documents")
+	errcode.Set(err, errQueryNotInSafelistCode)
+	return err
+}
+
+// isIntrospectionOnly returns true if every field selected at the root of an operation is an introspection field.
+func isIntrospectionOnly(doc *ast.QueryDocument, sel ast.SelectionSet) bool {
+	for _, s := range sel {
+		switch s := s.(type) {
+		case *ast.Field:
+			if s.Name != "__schema" && s.Name != "__type" && s.Name != "__typename" {
+				return false
+			}
+		case *ast.InlineFragment:
+			if !isIntrospectionOnly(doc, s.SelectionSet) {
+				return false
+			}
+		case *ast.FragmentSpread:
+			fragment := doc.Fragments.ForName(s.Name)
+			if fragment == nil || !isIntrospectionOnly(doc, fragment.SelectionSet) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func GetTrustedDocumentStats(ctx context.Context) *TrustedDocumentStats {
+	rc := graphql.GetOperationContext(ctx)
+	if rc == nil {
+		return nil
+	}
+
+	s, _ := rc.Stats.GetExtension(trustedDocumentsExtension).(*TrustedDocumentStats)
+	return s
+}
+
+// LoadTrustedDocuments reads a manifest of trusted documents from fsys, see ParseTrustedDocuments.
+func LoadTrustedDocuments(fsys fs.FS, name string) (map[string]string, error) {
+	b, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTrustedDocuments(b)
+}
+
+// LoadTrustedDocumentsFile reads a manifest of trusted documents from disk, see ParseTrustedDocuments.
+func LoadTrustedDocumentsFile(filename string) (map[string]string, error) {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTrustedDocuments(b)
+}
+
+// ParseTrustedDocuments parses a manifest of trusted documents and returns the documents by hash. Both the Apollo
+// persisted query manifest format and the Relay format, a JSON object mapping each hash to its document, are
+// supported.
+func ParseTrustedDocuments(manifest []byte) (map[string]string, error) {
+	var apollo struct {
+		Format     string `json:"format"`
+		Version    int    `json:"version"`
+		Operations []struct {
+			ID   string `json:"id"`
+			Body string `json:"body"`
+		} `json:"operations"`
+	}
+	if err := json.Unmarshal(manifest, &apollo); err == nil && apollo.Format == "apollo-persisted-query-manifest" {
+		if apollo.Version != 1 {
+			return nil, fmt.Errorf("unsupported apollo persisted query manifest version %d", apollo.Version)
+		}
+
+		documents := make(map[string]string, len(apollo.Operations))
+		for _, op := range apollo.Operations {
+			documents[op.ID] = op.Body
+		}
+		return documents, nil
+	}
+
+	var documents map[string]string
+	if err := json.Unmarshal(manifest, &documents); err != nil {
+		return nil, fmt.Errorf("unable to parse trusted documents manifest: %w", err)
+	}
+	return documents, nil
+}
@@ -0,0 +1,149 @@
+package extension_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"testing/fstest"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/testserver"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrustedDocuments(t *testing.T) {
+	const hash = "30166fc3298853f22709fce1e4a00e98f1b6a3160eaaaf9cb3b7db6a16073b07"
+	documents := map[string]string{hash: "{ name }"}
+
+	initialize := func(ext *extension.TrustedDocuments) (*testserver.TestServer, **extension.TrustedDocumentStats) {
+		h := testserver.New()
+		h.Use(ext)
+		h.AddTransport(&transport.POST{})
+
+		var stats *extension.TrustedDocumentStats
+		h.AroundResponses(func(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+			stats = extension.GetTrustedDocumentStats(ctx)
+			return next(ctx)
+		})
+		return h, &stats
+	}
+
+	t.Run("trusted hash", func(t *testing.T) {
+		h, stats := initialize(&extension.TrustedDocuments{Documents: documents})
+
+		resp := doRequest(h, "POST", "/graphql", `{"extensions":{"persistedQuery":{"version":1,"sha256Hash":"`+hash+`"}}}`)
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		require.Equal(t, `{"data":{"name":"test"}}`, resp.Body.String())
+		require.Equal(t, &extension.TrustedDocumentStats{Hash: hash, Trusted: true}, *stats)
+	})
+
+	t.Run("trusted document", func(t *testing.T) {
+		h, stats := initialize(&extension.TrustedDocuments{Documents: documents})
+
+		resp := doRequest(h, "POST", "/graphql", `{"query":"{ name }"}`)
+		require.Equal(t, `{"data":{"name":"test"}}`, resp.Body.String())
+		require.Equal(t, &extension.TrustedDocumentStats{Hash: hash, Trusted: true}, *stats)
+	})
+
+	t.Run("unknown hash", func(t *testing.T) {
+		h, _ := initialize(&extension.TrustedDocuments{Documents: documents, LogOnly: true})
+
+		resp := doRequest(h, "POST", "/graphql", `{"extensions":{"persistedQuery":{"version":1,"sha256Hash":"unknown"}}}`)
+		require.Equal(t, `{"errors":[{"message":"PersistedQueryNotInList","extensions":{"code":"PERSISTED_QUERY_NOT_IN_LIST"}}],"data":null}`, resp.Body.String())
+	})
+
+	t.Run("document not matching its hash", func(t *testing.T) {
+		h, _ := initialize(&extension.TrustedDocuments{Documents: documents})
+
+		resp := doRequest(h, "POST", "/graphql", `{"query":"{ find(id: 1) }","extensions":{"persistedQuery":{"version":1,"sha256Hash":"`+hash+`"}}}`)
+		require.Equal(t, `{"errors":[{"message":"operation is not in the list of trusted documents","extensions":{"code":"QUERY_NOT_IN_SAFELIST"}}],"data":null}`, resp.Body.String())
+	})
+
+	t.Run("untrusted document", func(t *testing.T) {
+		var untrusted []string
+		h, _ := initialize(&extension.TrustedDocuments{
+			Documents: documents,
+			OnUntrusted: func(ctx context.Context, query string) {
+				untrusted = append(untrusted, query)
+			},
+		})
+
+		resp := doRequest(h, "POST", "/graphql", `{"query":"{ find(id: 1) }"}`)
+		require.Equal(t, `{"errors":[{"message":"operation is not in the list of trusted documents","extensions":{"code":"QUERY_NOT_IN_SAFELIST"}}],"data":null}`, resp.Body.String())
+		require.Equal(t, []string{"{ find(id: 1) }"}, untrusted)
+	})
+
+	t.Run("log only", func(t *testing.T) {
+		var untrusted []string
+		h, stats := initialize(&extension.TrustedDocuments{
+			Documents: documents,
+			LogOnly:   true,
+			OnUntrusted: func(ctx context.Context, query string) {
+				untrusted = append(untrusted, query)
+			},
+		})
+
+		resp := doRequest(h, "POST", "/graphql", `{"query":"query Name { name }"}`)
+		require.Equal(t, `{"data":{"name":"test"}}`, resp.Body.String())
+		require.Equal(t, []string{"query Name { name }"}, untrusted)
+		require.Equal(t, &extension.TrustedDocumentStats{}, *stats)
+	})
+
+	t.Run("introspection", func(t *testing.T) {
+		var untrusted []string
+		h, _ := initialize(&extension.TrustedDocuments{
+			Documents:          documents,
+			AllowIntrospection: true,
+			OnUntrusted: func(ctx context.Context, query string) {
+				untrusted = append(untrusted, query)
+			},
+		})
+
+		resp := doRequest(h, "POST", "/graphql", `{"query":"{ __typename ... on Query { __schema { queryType { name } } } }"}`)
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		require.NotContains(t, resp.Body.String(), "errors")
+		require.Empty(t, untrusted)
+
+		resp = doRequest(h, "POST", "/graphql", `{"query":"{ __typename find(id: 1) }"}`)
+		require.Equal(t, `{"errors":[{"message":"operation is not in the list of trusted documents","extensions":{"code":"QUERY_NOT_IN_SAFELIST"}}],"data":null}`, resp.Body.String())
+		require.Equal(t, []string{"{ __typename find(id: 1) }"}, untrusted)
+	})
+}
+
+func TestLoadTrustedDocuments(t *testing.T) {
+	fsys := fstest.MapFS{
+		"apollo.json": {Data: []byte(`{
+			"format": "apollo-persisted-query-manifest",
+			"version": 1,
+			"operations": [{"id": "abc", "name": "Name", "type": "query", "body": "query Name { name }"}]
+		}`)},
+		"relay.json":   {Data: []byte(`{"abc": "query Name { name }"}`)},
+		"invalid.json": {Data: []byte(`["query Name { name }"]`)},
+		"v2.json":      {Data: []byte(`{"format": "apollo-persisted-query-manifest", "version": 2}`)},
+	}
+
+	t.Run("apollo", func(t *testing.T) {
+		documents, err := extension.LoadTrustedDocuments(fsys, "apollo.json")
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"abc": "query Name { name }"}, documents)
+	})
+
+	t.Run("relay", func(t *testing.T) {
+		documents, err := extension.LoadTrustedDocuments(fsys, "relay.json")
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"abc": "query Name { name }"}, documents)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := extension.LoadTrustedDocuments(fsys, "invalid.json")
+		require.EqualError(t, err, "unable to parse trusted documents manifest: json: cannot unmarshal array into Go value of type map[string]string")
+
+		_, err = extension.LoadTrustedDocuments(fsys, "v2.json")
+		require.EqualError(t, err, "unsupported apollo persisted query manifest version 2")
+
+		_, err = extension.LoadTrustedDocuments(fsys, "missing.json")
+		require.Error(t, err)
+	})
+}
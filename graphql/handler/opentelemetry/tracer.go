@@ -0,0 +1,249 @@
+package opentelemetry
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/global"
+	"go.opentelemetry.io/otel/metric/instrument"
+	"go.opentelemetry.io/otel/metric/instrument/syncfloat64"
+	"go.opentelemetry.io/otel/metric/instrument/syncint64"
+	"go.opentelemetry.io/otel/metric/unit"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/99designs/gqlgen/graphql/handler/opentelemetry"
+
+// Attributes set on spans and metrics, following the OpenTelemetry semantic conventions for GraphQL servers.
+const (
+	OperationName = attribute.Key("graphql.operation.name")
+	OperationType = attribute.Key("graphql.operation.type")
+	Document      = attribute.Key("graphql.document")
+	FieldName     = attribute.Key("graphql.field.name")
+	FieldPath     = attribute.Key("graphql.field.path")
+	FieldType     = attribute.Key("graphql.field.type")
+	FieldParent   = attribute.Key("graphql.field.parent")
+	ErrorCount    = attribute.Key("graphql.errors.count")
+)
+
+type (
+	// Tracer creates OpenTelemetry spans for the parsing, validation and execution of every operation, and for the
+	// fields it resolves. It also records the duration and the number of errors of every operation in histograms.
+	Tracer struct {
+		// TracerProvider creates the spans, the global provider is used when it is nil.
+		TracerProvider trace.TracerProvider
+
+		// MeterProvider records the metrics, the global provider is used when it is nil.
+		MeterProvider metric.MeterProvider
+
+		// FieldFilter decides which fields get a span of their own, every field does when it is nil. Tracing only
+		// the fields with a resolver greatly reduces the number of spans:
+		//
+		//  FieldFilter: func(fc *graphql.FieldContext) bool { return fc.IsResolver }
+		FieldFilter func(fc *graphql.FieldContext) bool
+
+		// IncludeDocument adds the operation document to its span. Documents can be large and hold sensitive
+		// arguments, so they are left out by default.
+		IncludeDocument bool
+
+		tracer   trace.Tracer
+		duration syncfloat64.Histogram
+		errors   syncint64.Histogram
+	}
+
+	// operationSpan tracks an operation from its first response to its last one
+	operationSpan struct {
+		span  trace.Span
+		start time.Time
+		attrs []attribute.KeyValue
+
+		mu     sync.Mutex
+		errors int
+		ended  bool
+	}
+
+	operationSpanKey struct{}
+)
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationInterceptor
+	graphql.ResponseInterceptor
+	graphql.FieldInterceptor
+} = &Tracer{}
+
+func (Tracer) ExtensionName() string {
+	return "OpenTelemetry"
+}
+
+func (t *Tracer) Validate(graphql.ExecutableSchema) error {
+	tp := t.TracerProvider
+	if tp == nil {
+		tp = otel.GetTracerProvider()
+	}
+	t.tracer = tp.Tracer(instrumentationName)
+
+	mp := t.MeterProvider
+	if mp == nil {
+		mp = global.MeterProvider()
+	}
+	meter := mp.Meter(instrumentationName)
+
+	var err error
+	t.duration, err = meter.SyncFloat64().Histogram("graphql.operation.duration",
+		instrument.WithUnit(unit.Milliseconds),
+		instrument.WithDescription("Duration of GraphQL operations, from reading the request to their last response"))
+	if err != nil {
+		return fmt.Errorf("unable to create duration histogram: %w", err)
+	}
+	t.errors, err = meter.SyncInt64().Histogram("graphql.operation.errors",
+		instrument.WithUnit(unit.Dimensionless),
+		instrument.WithDescription("Number of errors returned by GraphQL operations"))
+	if err != nil {
+		return fmt.Errorf("unable to create errors histogram: %w", err)
+	}
+	return nil
+}
+
+func (t Tracer) InterceptOperation(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+	ctx, op := t.startOperation(ctx)
+	if op == nil {
+		return next(ctx)
+	}
+	return next(context.WithValue(ctx, operationSpanKey{}, op))
+}
+
+func (t Tracer) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	op, _ := ctx.Value(operationSpanKey{}).(*operationSpan)
+	if op == nil {
+		// operations that failed to parse or validate are never executed, but still get a span
+		ctx, op = t.startOperation(ctx)
+		if op == nil {
+			return next(ctx)
+		}
+		resp := next(ctx)
+		t.endOperation(ctx, op, resp, true)
+		return resp
+	}
+
+	resp := next(ctx)
+	last := resp == nil || ((resp.HasNext == nil || !*resp.HasNext) && graphql.GetOperationContext(ctx).Operation.Operation != ast.Subscription)
+	t.endOperation(ctx, op, resp, last)
+	return resp
+}
+
+func (t Tracer) InterceptField(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil || (t.FieldFilter != nil && !t.FieldFilter(fc)) {
+		return next(ctx)
+	}
+
+	attrs := []attribute.KeyValue{
+		FieldName.String(fc.Field.Name),
+		FieldPath.String(fc.Path().String()),
+		FieldParent.String(fc.Object),
+	}
+	if fc.Field.Definition != nil {
+		attrs = append(attrs, FieldType.String(fc.Field.Definition.Type.String()))
+	}
+	ctx, span := t.tracer.Start(ctx, fc.Object+"."+fc.Field.Name, trace.WithAttributes(attrs...))
+	defer span.End()
+
+	res, err := next(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	if errs := graphql.GetFieldErrors(ctx, fc); len(errs) > 0 {
+		span.SetStatus(codes.Error, errs.Error())
+	}
+	return res, err
+}
+
+// startOperation starts the span of an operation, along with the spans of its parsing and validation which already
+// happened.
+func (t Tracer) startOperation(ctx context.Context) (context.Context, *operationSpan) {
+	if !graphql.HasOperationContext(ctx) {
+		return ctx, nil
+	}
+	rc := graphql.GetOperationContext(ctx)
+
+	op := &operationSpan{start: rc.Stats.OperationStart}
+	if op.start.IsZero() {
+		op.start = graphql.Now()
+	}
+
+	name := "GraphQL Operation"
+	if rc.Operation != nil {
+		op.attrs = append(op.attrs, OperationType.String(string(rc.Operation.Operation)))
+		name = string(rc.Operation.Operation)
+		if rc.Operation.Name != "" {
+			op.attrs = append(op.attrs, OperationName.String(rc.Operation.Name))
+			name += " " + rc.Operation.Name
+		}
+	}
+
+	attrs := op.attrs
+	if t.IncludeDocument && rc.RawQuery != "" {
+		attrs = append(attrs[:len(attrs):len(attrs)], Document.String(rc.RawQuery))
+	}
+	ctx, op.span = t.tracer.Start(ctx, name, trace.WithTimestamp(op.start), trace.WithAttributes(attrs...))
+
+	t.timingSpan(ctx, "graphql.parse", rc.Stats.Parsing)
+	t.timingSpan(ctx, "graphql.validate", rc.Stats.Validation)
+	return ctx, op
+}
+
+func (t Tracer) timingSpan(ctx context.Context, name string, timing graphql.TraceTiming) {
+	if timing.Start.IsZero() || timing.End.IsZero() {
+		return
+	}
+	_, span := t.tracer.Start(ctx, name, trace.WithTimestamp(timing.Start))
+	span.End(trace.WithTimestamp(timing.End))
+}
+
+// endOperation records the errors of a response, and ends the operation when it is the last response.
+func (t Tracer) endOperation(ctx context.Context, op *operationSpan, resp *graphql.Response, last bool) {
+	op.mu.Lock()
+	defer op.mu.Unlock()
+
+	if op.ended {
+		return
+	}
+
+	if resp != nil {
+		for _, err := range resp.Errors {
+			op.span.RecordError(err)
+			op.errors++
+		}
+		for _, result := range resp.Incremental {
+			for _, err := range result.Errors {
+				op.span.RecordError(err)
+				op.errors++
+			}
+		}
+	}
+
+	if !last {
+		return
+	}
+	op.ended = true
+
+	end := graphql.Now()
+	if op.errors > 0 {
+		op.span.SetStatus(codes.Error, fmt.Sprintf("%d errors", op.errors))
+	}
+	op.span.SetAttributes(ErrorCount.Int(op.errors))
+	op.span.End(trace.WithTimestamp(end))
+
+	t.duration.Record(ctx, float64(end.Sub(op.start))/float64(time.Millisecond), op.attrs...)
+	t.errors.Record(ctx, int64(op.errors), op.attrs...)
+}
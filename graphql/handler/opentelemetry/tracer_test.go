@@ -0,0 +1,126 @@
+package opentelemetry_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/opentelemetry"
+	"github.com/99designs/gqlgen/graphql/handler/testserver"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/metric/metrictest"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestTracer(t *testing.T) {
+	initialize := func(tracer *opentelemetry.Tracer) (*testserver.TestServer, *tracetest.SpanRecorder, *metrictest.Exporter) {
+		spans := tracetest.NewSpanRecorder()
+		mp, metrics := metrictest.NewTestMeterProvider()
+		tracer.TracerProvider = sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans))
+		tracer.MeterProvider = mp
+
+		h := testserver.New()
+		h.AddTransport(transport.POST{})
+		h.Use(tracer)
+		return h, spans, metrics
+	}
+
+	t.Run("operation", func(t *testing.T) {
+		h, spans, metrics := initialize(&opentelemetry.Tracer{IncludeDocument: true})
+
+		resp := doRequest(h, http.MethodPost, "/graphql", `{"query":"query Name { name }"}`)
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+
+		ended := spans.Ended()
+		require.Len(t, ended, 4)
+		parse, validate, field, operation := ended[0], ended[1], ended[2], ended[3]
+
+		require.Equal(t, "query Name", operation.Name())
+		require.Equal(t, codes.Unset, operation.Status().Code)
+		require.ElementsMatch(t, []attribute.KeyValue{
+			opentelemetry.OperationType.String("query"),
+			opentelemetry.OperationName.String("Name"),
+			opentelemetry.Document.String("query Name { name }"),
+			opentelemetry.ErrorCount.Int(0),
+		}, operation.Attributes())
+
+		require.Equal(t, "graphql.parse", parse.Name())
+		require.Equal(t, "graphql.validate", validate.Name())
+		require.Equal(t, "Query.name", field.Name())
+		require.ElementsMatch(t, []attribute.KeyValue{
+			opentelemetry.FieldName.String("name"),
+			opentelemetry.FieldPath.String("name"),
+			opentelemetry.FieldParent.String("Query"),
+			opentelemetry.FieldType.String("String!"),
+		}, field.Attributes())
+
+		for _, span := range []sdktrace.ReadOnlySpan{parse, validate, field} {
+			require.Equal(t, operation.SpanContext().SpanID(), span.Parent().SpanID(), span.Name())
+			require.Equal(t, operation.SpanContext().TraceID(), span.SpanContext().TraceID(), span.Name())
+		}
+
+		require.NoError(t, metrics.Collect(context.Background()))
+		duration, err := metrics.GetByName("graphql.operation.duration")
+		require.NoError(t, err)
+		require.EqualValues(t, 1, duration.Count)
+		errors, err := metrics.GetByName("graphql.operation.errors")
+		require.NoError(t, err)
+		require.EqualValues(t, 1, errors.Count)
+		require.EqualValues(t, 0, errors.Sum.AsInt64())
+	})
+
+	t.Run("validation failure", func(t *testing.T) {
+		h, spans, metrics := initialize(&opentelemetry.Tracer{})
+
+		resp := doRequest(h, http.MethodPost, "/graphql", `{"query":"{ title }"}`)
+		require.Equal(t, http.StatusUnprocessableEntity, resp.Code, resp.Body.String())
+
+		ended := spans.Ended()
+		require.Len(t, ended, 2)
+		require.Equal(t, "graphql.parse", ended[0].Name())
+
+		operation := ended[1]
+		require.Equal(t, "GraphQL Operation", operation.Name())
+		require.Equal(t, codes.Error, operation.Status().Code)
+		require.Len(t, operation.Events(), 1)
+		require.Equal(t, "exception", operation.Events()[0].Name)
+
+		require.NoError(t, metrics.Collect(context.Background()))
+		errors, err := metrics.GetByName("graphql.operation.errors")
+		require.NoError(t, err)
+		require.EqualValues(t, 1, errors.Sum.AsInt64())
+	})
+
+	t.Run("field filter", func(t *testing.T) {
+		h, spans, _ := initialize(&opentelemetry.Tracer{
+			FieldFilter: func(fc *graphql.FieldContext) bool {
+				return fc.IsResolver
+			},
+		})
+
+		resp := doRequest(h, http.MethodPost, "/graphql", `{"query":"{ name }"}`)
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+
+		var names []string
+		for _, span := range spans.Ended() {
+			names = append(names, span.Name())
+		}
+		require.Equal(t, []string{"graphql.parse", "graphql.validate", "query"}, names)
+	})
+}
+
+func doRequest(handler http.Handler, method string, target string, body string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(method, target, strings.NewReader(body))
+	r.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	handler.ServeHTTP(w, r)
+	return w
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/99designs/gqlgen/graphql/handler/extension"
 	"github.com/99designs/gqlgen/graphql/handler/lru"
 	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/valyala/fasthttp"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
@@ -19,12 +20,14 @@ type (
 	Server struct {
 		transports []graphql.Transport
 		exec       *executor.Executor
+		drainer    *transport.Drainer
 	}
 )
 
 func New(es graphql.ExecutableSchema) *Server {
 	return &Server{
-		exec: executor.New(es),
+		exec:    executor.New(es),
+		drainer: transport.NewDrainer(),
 	}
 }
 
@@ -65,6 +68,22 @@ func (s *Server) SetQueryCache(cache graphql.Cache) {
 	s.exec.SetQueryCache(cache)
 }
 
+// SetConcurrencyLimit limits the number of goroutines resolving the fields of each operation, 0 means no limit.
+func (s *Server) SetConcurrencyLimit(limit int) {
+	s.exec.SetConcurrencyLimit(limit)
+}
+
+// SetServerConcurrencyLimit limits the number of goroutines resolving fields across all the operations of the server,
+// 0 means no limit.
+func (s *Server) SetServerConcurrencyLimit(limit int) {
+	s.exec.SetServerConcurrencyLimit(limit)
+}
+
+// SetInlineFields sets which of the fields with resolvers are resolved without a goroutine of their own.
+func (s *Server) SetInlineFields(inline func(field graphql.CollectedField) bool) {
+	s.exec.SetInlineFields(inline)
+}
+
 func (s *Server) Use(extension graphql.HandlerExtension) {
 	s.exec.Use(extension)
 }
@@ -109,7 +128,14 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		}
 	}()
 
-	r = r.WithContext(graphql.StartOperationTrace(r.Context()))
+	if !s.drainer.Accept() {
+		w.Header().Set("Connection", "close")
+		sendErrorf(w, http.StatusServiceUnavailable, "server shutting down")
+		return
+	}
+	defer s.drainer.Release()
+
+	r = r.WithContext(transport.WithDrainer(graphql.StartOperationTrace(r.Context()), s.drainer))
 
 	transport := s.getTransport(r)
 	if transport == nil {
@@ -120,6 +146,59 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	transport.Do(w, r, s.exec)
 }
 
+func (s *Server) getFastTransport(rctx *fasthttp.RequestCtx) transport.FastTransport {
+	for _, t := range s.transports {
+		if t, ok := t.(transport.FastTransport); ok && t.SupportsFastHTTP(rctx) {
+			return t
+		}
+	}
+	return nil
+}
+
+// ServeFastHTTP serves graphql requests directly from fasthttp, using every added transport that implements
+// transport.FastTransport. In a Fiber app it can be mounted with
+//
+//	app.All("/query", func(c *fiber.Ctx) error {
+//		srv.ServeFastHTTP(c.Context())
+//		return nil
+//	})
+func (s *Server) ServeFastHTTP(rctx *fasthttp.RequestCtx) {
+	defer func() {
+		if err := recover(); err != nil {
+			err := s.exec.PresentRecoveredError(rctx, err)
+			resp := &graphql.Response{Errors: []*gqlerror.Error{err}}
+			b, _ := json.Marshal(resp)
+			rctx.SetStatusCode(http.StatusUnprocessableEntity)
+			rctx.SetBody(b)
+		}
+	}()
+
+	if !s.drainer.Accept() {
+		rctx.SetConnectionClose()
+		sendFastErrorf(rctx, http.StatusServiceUnavailable, "server shutting down")
+		return
+	}
+	defer s.drainer.Release()
+
+	ctx := transport.WithDrainer(graphql.StartOperationTrace(rctx), s.drainer)
+
+	transport := s.getFastTransport(rctx)
+	if transport == nil {
+		sendFastErrorf(rctx, http.StatusBadRequest, "transport not supported")
+		return
+	}
+
+	transport.DoFastHTTP(ctx, rctx, s.exec)
+}
+
+// Shutdown gracefully shuts down the server: new requests are turned away, active subscriptions are completed and
+// their websockets closed as going away, then Shutdown waits for the operations in flight to finish. It returns once
+// they have, or with the error of ctx if it is done first. The underlying http server still has to be shut down
+// separately, after the graphql server has been drained.
+func (s *Server) Shutdown(ctx context.Context) error {
+	return s.drainer.Drain(ctx)
+}
+
 func sendError(w http.ResponseWriter, code int, errors ...*gqlerror.Error) {
 	w.WriteHeader(code)
 	b, err := json.Marshal(&graphql.Response{Errors: errors})
@@ -133,6 +212,19 @@ func sendErrorf(w http.ResponseWriter, code int, format string, args ...interfac
 	sendError(w, code, &gqlerror.Error{Message: fmt.Sprintf(format, args...)})
 }
 
+func sendFastError(rctx *fasthttp.RequestCtx, code int, errors ...*gqlerror.Error) {
+	rctx.SetStatusCode(code)
+	b, err := json.Marshal(&graphql.Response{Errors: errors})
+	if err != nil {
+		panic(err)
+	}
+	rctx.SetBody(b)
+}
+
+func sendFastErrorf(rctx *fasthttp.RequestCtx, code int, format string, args ...interface{}) {
+	sendFastError(rctx, code, &gqlerror.Error{Message: fmt.Sprintf(format, args...)})
+}
+
 type OperationFunc func(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler
 
 func (r OperationFunc) ExtensionName() string {
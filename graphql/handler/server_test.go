@@ -7,12 +7,14 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/handler/testserver"
 	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/valyala/fasthttp"
 	"github.com/vektah/gqlparser/v2/ast"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 	"github.com/vektah/gqlparser/v2/parser"
@@ -142,6 +144,42 @@ func TestServer(t *testing.T) {
 	})
 }
 
+func TestServerFastHTTP(t *testing.T) {
+	srv := testserver.New()
+	srv.AddTransport(&transport.GET{})
+
+	t.Run("returns an error if no transport matches", func(t *testing.T) {
+		resp := fastRequest(srv, "POST", "/foo")
+		assert.Equal(t, http.StatusBadRequest, resp.Response.StatusCode())
+		assert.Equal(t, `{"errors":[{"message":"transport not supported"}],"data":null}`, string(resp.Response.Body()))
+	})
+
+	t.Run("calls query on executable schema", func(t *testing.T) {
+		resp := fastRequest(srv, "GET", "/foo?query={name}")
+		assert.Equal(t, http.StatusOK, resp.Response.StatusCode())
+		assert.Equal(t, `{"data":{"name":"test"}}`, string(resp.Response.Body()))
+	})
+
+	t.Run("shares extensions with net/http requests", func(t *testing.T) {
+		var calls []string
+		srv.AroundOperations(func(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+			calls = append(calls, "operation")
+			return next(ctx)
+		})
+		srv.AroundFields(func(ctx context.Context, next graphql.Resolver) (res interface{}, err error) {
+			calls = append(calls, "field")
+			return next(ctx)
+		})
+
+		resp := fastRequest(srv, "GET", "/foo?query={name}")
+		assert.Equal(t, http.StatusOK, resp.Response.StatusCode(), string(resp.Response.Body()))
+		assert.Equal(t, []string{"operation", "field"}, calls)
+
+		get(srv, "/foo?query={name}")
+		assert.Equal(t, []string{"operation", "field", "operation", "field"}, calls)
+	})
+}
+
 func TestErrorServer(t *testing.T) {
 	srv := testserver.NewError()
 	srv.AddTransport(&transport.GET{})
@@ -173,6 +211,14 @@ func (h panicTransport) Do(w http.ResponseWriter, r *http.Request, exec graphql.
 	panic(fmt.Errorf("panic in transport"))
 }
 
+func (t panicTransport) SupportsFastHTTP(rctx *fasthttp.RequestCtx) bool {
+	return true
+}
+
+func (h panicTransport) DoFastHTTP(ctx context.Context, rctx *fasthttp.RequestCtx, exec graphql.GraphExecutor) {
+	panic(fmt.Errorf("panic in transport"))
+}
+
 func TestRecover(t *testing.T) {
 	srv := testserver.New()
 	srv.AddTransport(&panicTransport{})
@@ -182,6 +228,78 @@ func TestRecover(t *testing.T) {
 
 		assert.Equal(t, http.StatusUnprocessableEntity, resp.Code, resp.Body.String())
 	})
+
+	t.Run("recover from panic with fasthttp", func(t *testing.T) {
+		resp := fastRequest(srv, "GET", "/foo?query={name}")
+
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.Response.StatusCode(), string(resp.Response.Body()))
+	})
+}
+
+// blockingTransport answers requests once release is closed.
+type blockingTransport struct {
+	started chan struct{}
+	release chan struct{}
+}
+
+func (t blockingTransport) Supports(r *http.Request) bool {
+	return true
+}
+
+func (t blockingTransport) Do(w http.ResponseWriter, r *http.Request, exec graphql.GraphExecutor) {
+	t.started <- struct{}{}
+	<-t.release
+	w.WriteHeader(http.StatusOK)
+}
+
+func (t blockingTransport) SupportsFastHTTP(rctx *fasthttp.RequestCtx) bool {
+	return true
+}
+
+func (t blockingTransport) DoFastHTTP(ctx context.Context, rctx *fasthttp.RequestCtx, exec graphql.GraphExecutor) {
+	t.started <- struct{}{}
+	<-t.release
+	rctx.SetStatusCode(http.StatusOK)
+}
+
+func TestShutdown(t *testing.T) {
+	t.Run("waits for the requests in flight", func(t *testing.T) {
+		srv := testserver.New()
+		blocking := blockingTransport{started: make(chan struct{}), release: make(chan struct{})}
+		srv.AddTransport(blocking)
+
+		done := make(chan int, 2)
+		go func() { done <- get(srv, "/foo?query={name}").Code }()
+		go func() { done <- fastRequest(srv, "GET", "/foo?query={name}").Response.StatusCode() }()
+		<-blocking.started
+		<-blocking.started
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		require.Equal(t, context.DeadlineExceeded, srv.Shutdown(ctx))
+
+		shutdown := make(chan error)
+		go func() { shutdown <- srv.Shutdown(context.Background()) }()
+
+		close(blocking.release)
+		assert.Equal(t, http.StatusOK, <-done)
+		assert.Equal(t, http.StatusOK, <-done)
+		require.NoError(t, <-shutdown)
+	})
+
+	t.Run("turns away new requests", func(t *testing.T) {
+		srv := testserver.New()
+		srv.AddTransport(&transport.GET{})
+		require.NoError(t, srv.Shutdown(context.Background()))
+
+		resp := get(srv, "/foo?query={name}")
+		assert.Equal(t, http.StatusServiceUnavailable, resp.Code)
+		assert.Equal(t, `{"errors":[{"message":"server shutting down"}],"data":null}`, resp.Body.String())
+
+		fastResp := fastRequest(srv, "GET", "/foo?query={name}")
+		assert.Equal(t, http.StatusServiceUnavailable, fastResp.Response.StatusCode())
+		assert.Equal(t, `{"errors":[{"message":"server shutting down"}],"data":null}`, string(fastResp.Response.Body()))
+	})
 }
 
 func get(handler http.Handler, target string) *httptest.ResponseRecorder {
@@ -200,3 +318,15 @@ func post(handler http.Handler, target, contentType string) *httptest.ResponseRe
 	handler.ServeHTTP(w, r)
 	return w
 }
+
+func fastRequest(handler *testserver.TestServer, method, target string) *fasthttp.RequestCtx {
+	var req fasthttp.Request
+	req.Header.SetMethod(method)
+	req.SetRequestURI(target)
+
+	var rctx fasthttp.RequestCtx
+	rctx.Init(&req, nil, nil)
+
+	handler.ServeFastHTTP(&rctx)
+	return &rctx
+}
@@ -15,11 +15,16 @@ import (
 // a generated server, but it aims to be good enough to test the handler package without relying on codegen.
 func New() *TestServer {
 	next := make(chan struct{})
+	completeSubscription := make(chan struct{})
 
 	schema := gqlparser.MustLoadSchema(&ast.Source{Input: `
+		directive @defer(if: Boolean = true, label: String) on FRAGMENT_SPREAD | INLINE_FRAGMENT
+		directive @cost(weight: String!) on FIELD_DEFINITION
+		directive @cacheControl(maxAge: Int) on FIELD_DEFINITION
 		type Query {
-			name: String!
-			find(id: Int!): String!
+			name: String! @cacheControl(maxAge: 60)
+			find(id: Int!): String! @cost(weight: "5")
+			weighted: String! @cost(weight: "2")
 		}
 		type Mutation {
 			name: String!
@@ -30,7 +35,8 @@ func New() *TestServer {
 	`})
 
 	srv := &TestServer{
-		next: next,
+		next:                 next,
+		completeSubscription: completeSubscription,
 	}
 
 	srv.Server = handler.New(&graphql.ExecutableSchemaMock{
@@ -38,6 +44,11 @@ func New() *TestServer {
 			rc := graphql.GetOperationContext(ctx)
 			switch rc.Operation.Operation {
 			case ast.Query:
+				if rc.IncrementalDelivery {
+					if fields, deferred := graphql.SplitDeferred(graphql.CollectFields(rc, rc.Operation.SelectionSet, nil)); len(deferred) > 0 {
+						return incrementalResponses(fields, deferred)
+					}
+				}
 				ran := false
 				return func(ctx context.Context) *graphql.Response {
 					if ran {
@@ -47,13 +58,7 @@ func New() *TestServer {
 					// Field execution happens inside the generated code, lets simulate some of it.
 					ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
 						Object: "Query",
-						Field: graphql.CollectedField{
-							Field: &ast.Field{
-								Name:       "name",
-								Alias:      "name",
-								Definition: schema.Types["Query"].Fields.ForName("name"),
-							},
-						},
+						Field:  graphql.CollectedField{Field: simulatedField(schema, rc.Operation)},
 					})
 					res, err := graphql.GetOperationContext(ctx).ResolverMiddleware(ctx, func(ctx context.Context) (interface{}, error) {
 						return &graphql.Response{Data: []byte(`{"name":"test"}`)}, nil
@@ -70,6 +75,8 @@ func New() *TestServer {
 					select {
 					case <-ctx.Done():
 						return nil
+					case <-completeSubscription:
+						return nil
 					case <-next:
 						return &graphql.Response{
 							Data: []byte(`{"name":"test"}`),
@@ -90,6 +97,55 @@ func New() *TestServer {
 	return srv
 }
 
+// simulatedField is the field resolved by queries, the first field selected by op or name if it does not select one.
+func simulatedField(schema *ast.Schema, op *ast.OperationDefinition) *ast.Field {
+	name := "name"
+	if len(op.SelectionSet) > 0 {
+		if f, ok := op.SelectionSet[0].(*ast.Field); ok && schema.Query.Fields.ForName(f.Name) != nil {
+			name = f.Name
+		}
+	}
+	return &ast.Field{
+		Name:       name,
+		Alias:      name,
+		Definition: schema.Query.Fields.ForName(name),
+	}
+}
+
+// incrementalResponses simulates the payloads sent for a query using @defer, resolving every field to "test".
+func incrementalResponses(fields []graphql.CollectedField, deferred []graphql.DeferredGroup) graphql.ResponseHandler {
+	hasNext, done := true, false
+	initial := &graphql.Response{Data: []byte(`{}`), HasNext: &hasNext}
+	if len(fields) > 0 {
+		initial.Data = []byte(`{"name":"test"}`)
+	}
+
+	responses := []*graphql.Response{initial}
+	for i, group := range deferred {
+		next := &hasNext
+		if i == len(deferred)-1 {
+			next = &done
+		}
+		responses = append(responses, &graphql.Response{
+			Incremental: []*graphql.IncrementalResult{{
+				Data:  []byte(`{"name":"test"}`),
+				Path:  ast.Path{},
+				Label: group.Label,
+			}},
+			HasNext: next,
+		})
+	}
+
+	return func(ctx context.Context) *graphql.Response {
+		if len(responses) == 0 {
+			return nil
+		}
+		resp := responses[0]
+		responses = responses[1:]
+		return resp
+	}
+}
+
 // NewError provides a server for use in resolver error tests that isn't relying on generated code. It isnt a perfect reproduction of
 // a generated server, but it aims to be good enough to test the handler package without relying on codegen.
 func NewError() *TestServer {
@@ -143,8 +199,9 @@ func NewError() *TestServer {
 
 type TestServer struct {
 	*handler.Server
-	next       chan struct{}
-	complexity int
+	next                 chan struct{}
+	completeSubscription chan struct{}
+	complexity           int
 }
 
 func (s *TestServer) SendNextSubscriptionMessage() {
@@ -155,6 +212,15 @@ func (s *TestServer) SendNextSubscriptionMessage() {
 	}
 }
 
+// SendCompleteSubscriptionMessage ends the active subscription
+func (s *TestServer) SendCompleteSubscriptionMessage() {
+	select {
+	case s.completeSubscription <- struct{}{}:
+	case <-time.After(1 * time.Second):
+		fmt.Println("WARNING: no active subscription")
+	}
+}
+
 func (s *TestServer) SetCalculatedComplexity(complexity int) {
 	s.complexity = complexity
 }
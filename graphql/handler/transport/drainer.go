@@ -0,0 +1,122 @@
+package transport
+
+import (
+	"context"
+	"sync"
+)
+
+// Drainer tracks the requests a server is serving, so it can wait for them to finish when it shuts down. Once the
+// server starts shutting down, new requests are turned away and the long-lived ones, like subscriptions, are ended.
+//
+// A nil Drainer accepts every request and never shuts down.
+type Drainer struct {
+	mu           sync.Mutex
+	active       int
+	shuttingDown bool
+	closing      chan struct{}
+	drained      chan struct{}
+}
+
+type drainerCtxKey struct{}
+
+func NewDrainer() *Drainer {
+	return &Drainer{
+		closing: make(chan struct{}),
+		drained: make(chan struct{}),
+	}
+}
+
+// WithDrainer makes the transports serving a request track the work they do for it with d.
+func WithDrainer(ctx context.Context, d *Drainer) context.Context {
+	return context.WithValue(ctx, drainerCtxKey{}, d)
+}
+
+// GetDrainer returns the drainer of the server serving the request of ctx, if any.
+func GetDrainer(ctx context.Context) *Drainer {
+	d, _ := ctx.Value(drainerCtxKey{}).(*Drainer)
+	return d
+}
+
+// Accept starts tracking a new request, unless the server is shutting down. Accepted requests must be released once
+// they are done.
+func (d *Drainer) Accept() bool {
+	if d == nil {
+		return true
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.shuttingDown {
+		return false
+	}
+	d.active++
+	return true
+}
+
+// Hold keeps the server from being drained until the matching Release, even when it is shutting down. It is meant
+// for the work an accepted request leaves behind, like the body of a response written after the handler returns.
+func (d *Drainer) Hold() {
+	if d == nil {
+		return
+	}
+	d.mu.Lock()
+	d.active++
+	d.mu.Unlock()
+}
+
+// Release stops tracking a request accepted, or work held, before.
+func (d *Drainer) Release() {
+	if d == nil {
+		return
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.active--
+	if d.shuttingDown && d.active == 0 {
+		close(d.drained)
+	}
+}
+
+// ShuttingDown returns a channel closed once the server starts shutting down.
+func (d *Drainer) ShuttingDown() <-chan struct{} {
+	if d == nil {
+		return nil
+	}
+	return d.closing
+}
+
+// Drain turns away new requests, ends the long-lived ones and waits for the others to finish, or for ctx to be done.
+func (d *Drainer) Drain(ctx context.Context) error {
+	d.mu.Lock()
+	if !d.shuttingDown {
+		d.shuttingDown = true
+		close(d.closing)
+		if d.active == 0 {
+			close(d.drained)
+		}
+	}
+	d.mu.Unlock()
+
+	select {
+	case <-d.drained:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// cancelOnShutdown returns a copy of ctx cancelled once the server starts shutting down.
+func cancelOnShutdown(ctx context.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(ctx)
+	if closing := GetDrainer(ctx).ShuttingDown(); closing != nil {
+		go func() {
+			select {
+			case <-closing:
+				cancel()
+			case <-ctx.Done():
+			}
+		}()
+	}
+	return ctx, cancel
+}
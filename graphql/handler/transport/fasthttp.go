@@ -0,0 +1,92 @@
+package transport
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/valyala/fasthttp"
+)
+
+// FastTransport is implemented by transports that can also serve requests natively from fasthttp (and therefore
+// Fiber) without going through the net/http adaptor. ctx is derived from rctx and carries the operation trace.
+type FastTransport interface {
+	SupportsFastHTTP(rctx *fasthttp.RequestCtx) bool
+	DoFastHTTP(ctx context.Context, rctx *fasthttp.RequestCtx, exec graphql.GraphExecutor)
+}
+
+// responseWriter is the part of http.ResponseWriter used by the transports to reply to a request. It lets the same
+// code write responses for both net/http and fasthttp requests.
+type responseWriter interface {
+	io.Writer
+	WriteHeader(statusCode int)
+}
+
+// fastHTTPResponseWriter adapts a fasthttp request to a responseWriter
+type fastHTTPResponseWriter struct {
+	rctx *fasthttp.RequestCtx
+}
+
+func (w fastHTTPResponseWriter) Write(b []byte) (int, error) {
+	return w.rctx.Write(b)
+}
+
+func (w fastHTTPResponseWriter) WriteHeader(statusCode int) {
+	w.rctx.SetStatusCode(statusCode)
+}
+
+// setHeader sets a header of the response written by w, before its status is written
+func setHeader(w responseWriter, key, value string) {
+	switch w := w.(type) {
+	case http.ResponseWriter:
+		w.Header().Set(key, value)
+	case fastHTTPResponseWriter:
+		w.rctx.Response.Header.Set(key, value)
+	}
+}
+
+// fastHTTPValues is a context that exposes the user values (eg Fiber locals) captured from a fasthttp request. The
+// fasthttp request context is recycled once its handler returns, so long lived operations such as websocket
+// subscriptions must not hold on to it.
+type fastHTTPValues struct {
+	context.Context
+	values map[string]interface{}
+}
+
+// fastHTTPQuery returns the query string arguments of a fasthttp request
+func fastHTTPQuery(rctx *fasthttp.RequestCtx) url.Values {
+	query := url.Values{}
+	rctx.QueryArgs().VisitAll(func(key, value []byte) {
+		query.Add(string(key), string(value))
+	})
+	return query
+}
+
+// fastHTTPHeaders returns the headers of a fasthttp request
+func fastHTTPHeaders(rctx *fasthttp.RequestCtx) http.Header {
+	headers := http.Header{}
+	rctx.Request.Header.VisitAll(func(key, value []byte) {
+		headers.Add(string(key), string(value))
+	})
+	return headers
+}
+
+func detachFastHTTPContext(rctx *fasthttp.RequestCtx) context.Context {
+	values := map[string]interface{}{}
+	rctx.VisitUserValues(func(key []byte, value interface{}) {
+		values[string(key)] = value
+	})
+
+	return fastHTTPValues{Context: context.Background(), values: values}
+}
+
+func (c fastHTTPValues) Value(key interface{}) interface{} {
+	if key, ok := key.(string); ok {
+		if value, ok := c.values[key]; ok {
+			return value
+		}
+	}
+	return c.Context.Value(key)
+}
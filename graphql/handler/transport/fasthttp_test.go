@@ -0,0 +1,64 @@
+package transport_test
+
+import (
+	"io/ioutil"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/valyala/fasthttp"
+)
+
+type fastHTTPHandler interface {
+	ServeFastHTTP(rctx *fasthttp.RequestCtx)
+}
+
+type fastHTTPHandlerFunc func(rctx *fasthttp.RequestCtx)
+
+func (f fastHTTPHandlerFunc) ServeFastHTTP(rctx *fasthttp.RequestCtx) {
+	f(rctx)
+}
+
+func doFastRequest(h fastHTTPHandler, method string, target string, body string) *fasthttp.RequestCtx {
+	return doFastRequestWithContentType(h, method, target, body, "application/json")
+}
+
+func doFastRequestWithContentType(h fastHTTPHandler, method string, target string, body string, contentType string) *fasthttp.RequestCtx {
+	var req fasthttp.Request
+	req.Header.SetMethod(method)
+	req.SetRequestURI(target)
+	req.SetBodyString(body)
+	if contentType != "" {
+		req.Header.SetContentType(contentType)
+	}
+
+	var rctx fasthttp.RequestCtx
+	rctx.Init(&req, nil, nil)
+
+	h.ServeFastHTTP(&rctx)
+	return &rctx
+}
+
+// doFastHTTPRequest replays a net/http request against a fasthttp handler
+func doFastHTTPRequest(t *testing.T, h fastHTTPHandler, r *http.Request) *fasthttp.RequestCtx {
+	body, err := ioutil.ReadAll(r.Body)
+	require.NoError(t, err)
+
+	return doFastRequestWithContentType(h, r.Method, "/graphql", string(body), r.Header.Get("Content-Type"))
+}
+
+// serveFastHTTP starts a fasthttp server on a random local port and returns its url
+func serveFastHTTP(t *testing.T, h fastHTTPHandler) (url string, close func()) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	srv := &fasthttp.Server{Handler: h.ServeFastHTTP}
+	go func() {
+		_ = srv.Serve(ln)
+	}()
+
+	return "http://" + ln.Addr().String(), func() {
+		_ = ln.Close()
+	}
+}
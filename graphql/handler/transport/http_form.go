@@ -1,15 +1,20 @@
 package transport
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"io"
 	"io/ioutil"
 	"mime"
+	"mime/multipart"
 	"net/http"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/99designs/gqlgen/graphql"
+	"github.com/valyala/fasthttp"
 )
 
 // MultipartForm the Multipart request spec https://github.com/jaydenseric/graphql-multipart-request-spec
@@ -24,7 +29,10 @@ type MultipartForm struct {
 	MaxMemory int64
 }
 
-var _ graphql.Transport = MultipartForm{}
+var (
+	_ graphql.Transport = MultipartForm{}
+	_ FastTransport     = MultipartForm{}
+)
 
 func (f MultipartForm) Supports(r *http.Request) bool {
 	if r.Header.Get("Upgrade") != "" {
@@ -39,6 +47,19 @@ func (f MultipartForm) Supports(r *http.Request) bool {
 	return r.Method == "POST" && mediaType == "multipart/form-data"
 }
 
+func (f MultipartForm) SupportsFastHTTP(rctx *fasthttp.RequestCtx) bool {
+	if len(rctx.Request.Header.Peek("Upgrade")) != 0 {
+		return false
+	}
+
+	mediaType, _, err := mime.ParseMediaType(string(rctx.Request.Header.ContentType()))
+	if err != nil {
+		return false
+	}
+
+	return rctx.IsPost() && mediaType == "multipart/form-data"
+}
+
 func (f MultipartForm) maxUploadSize() int64 {
 	if f.MaxUploadSize == 0 {
 		return 32 << 20
@@ -75,16 +96,57 @@ func (f MultipartForm) Do(w http.ResponseWriter, r *http.Request, exec graphql.G
 	}
 	defer r.Body.Close()
 
+	f.do(r.Context(), w, r.MultipartForm, r.ContentLength, r.Header, start, exec)
+}
+
+func (f MultipartForm) DoFastHTTP(ctx context.Context, rctx *fasthttp.RequestCtx, exec graphql.GraphExecutor) {
+	rctx.SetContentType("application/json")
+	w := fastHTTPResponseWriter{rctx}
+
+	start := graphql.Now()
+
+	if int64(rctx.Request.Header.ContentLength()) > f.maxUploadSize() {
+		writeJsonError(w, "failed to parse multipart form, request body too large")
+		return
+	}
+	body := rctx.PostBody()
+	if int64(len(body)) > f.maxUploadSize() {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		writeJsonError(w, "failed to parse multipart form, request body too large")
+		return
+	}
+
+	_, mediaParams, err := mime.ParseMediaType(string(rctx.Request.Header.ContentType()))
+	if err != nil {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		writeJsonError(w, "failed to parse multipart form")
+		return
+	}
+	form, err := multipart.NewReader(bytes.NewReader(body), mediaParams["boundary"]).ReadForm(f.maxMemory())
+	if err != nil {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		writeJsonError(w, "failed to parse multipart form")
+		return
+	}
+	defer func() {
+		_ = form.RemoveAll()
+	}()
+
+	f.do(ctx, w, form, int64(len(body)), fastHTTPHeaders(rctx), start, exec)
+}
+
+func (f MultipartForm) do(ctx context.Context, w responseWriter, form *multipart.Form, contentLength int64, headers http.Header, start time.Time, exec graphql.GraphExecutor) {
+	var err error
 	var params graphql.RawParams
 
-	if err = jsonDecode(strings.NewReader(r.Form.Get("operations")), &params); err != nil {
+	if err = jsonDecode(strings.NewReader(formValue(form, "operations")), &params); err != nil {
 		w.WriteHeader(http.StatusUnprocessableEntity)
 		writeJsonError(w, "operations form field could not be decoded")
 		return
 	}
 
 	uploadsMap := map[string][]string{}
-	if err = json.Unmarshal([]byte(r.Form.Get("map")), &uploadsMap); err != nil {
+	if err = json.Unmarshal([]byte(formValue(form, "map")), &uploadsMap); err != nil {
 		w.WriteHeader(http.StatusUnprocessableEntity)
 		writeJsonError(w, "map form field could not be decoded")
 		return
@@ -97,7 +159,7 @@ func (f MultipartForm) Do(w http.ResponseWriter, r *http.Request, exec graphql.G
 			writeJsonErrorf(w, "invalid empty operations paths list for key %s", key)
 			return
 		}
-		file, header, err := r.FormFile(key)
+		file, header, err := formFile(form, key)
 		if err != nil {
 			w.WriteHeader(http.StatusUnprocessableEntity)
 			writeJsonErrorf(w, "failed to get key %s from form", key)
@@ -119,7 +181,7 @@ func (f MultipartForm) Do(w http.ResponseWriter, r *http.Request, exec graphql.G
 				return
 			}
 		} else {
-			if r.ContentLength < f.maxMemory() {
+			if contentLength < f.maxMemory() {
 				fileBytes, err := ioutil.ReadAll(file)
 				if err != nil {
 					w.WriteHeader(http.StatusUnprocessableEntity)
@@ -195,14 +257,30 @@ func (f MultipartForm) Do(w http.ResponseWriter, r *http.Request, exec graphql.G
 		Start: start,
 		End:   graphql.Now(),
 	}
+	params.Headers = headers
 
-	rc, gerr := exec.CreateOperationContext(r.Context(), &params)
+	rc, gerr := exec.CreateOperationContext(ctx, &params)
 	if gerr != nil {
-		resp := exec.DispatchError(graphql.WithOperationContext(r.Context(), rc), gerr)
+		resp := exec.DispatchError(graphql.WithOperationContext(ctx, rc), gerr)
 		w.WriteHeader(statusFor(gerr))
 		writeJson(w, resp)
 		return
 	}
-	responses, ctx := exec.DispatchOperation(r.Context(), rc)
+	responses, ctx := exec.DispatchOperation(ctx, rc)
 	writeJson(w, responses(ctx))
 }
+
+func formValue(form *multipart.Form, key string) string {
+	if values := form.Value[key]; len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+func formFile(form *multipart.Form, key string) (multipart.File, *multipart.FileHeader, error) {
+	if headers := form.File[key]; len(headers) > 0 {
+		file, err := headers[0].Open()
+		return file, headers[0], err
+	}
+	return nil, nil, http.ErrMissingFile
+}
@@ -0,0 +1,180 @@
+package transport_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+func TestFileUploadFastHTTP(t *testing.T) {
+	es := &graphql.ExecutableSchemaMock{
+		ExecFunc: func(ctx context.Context) graphql.ResponseHandler {
+			return graphql.OneShot(graphql.ErrorResponse(ctx, "not implemented"))
+		},
+		SchemaFunc: func() *ast.Schema {
+			return gqlparser.MustLoadSchema(&ast.Source{Input: `
+				type Mutation {
+					singleUpload(file: Upload!): String!
+					multipleUpload(files: [Upload!]!): String!
+					multipleUploadWithPayload(req: [UploadFile!]!): String!
+				}
+				scalar Upload
+				scalar UploadFile
+			`})
+		},
+	}
+
+	h := handler.New(es)
+	multipartForm := transport.MultipartForm{}
+	h.AddTransport(&multipartForm)
+
+	t.Run("valid single file upload", func(t *testing.T) {
+		es.ExecFunc = func(ctx context.Context) graphql.ResponseHandler {
+			rc := graphql.GetOperationContext(ctx)
+			require.Equal(t, len(rc.Operation.VariableDefinitions), 1)
+			require.Equal(t, rc.Operation.VariableDefinitions[0].Variable, "file")
+
+			upload := rc.Variables["file"].(graphql.Upload)
+			require.Equal(t, "a.txt", upload.Filename)
+			require.Equal(t, "text/plain", upload.ContentType)
+			content, err := ioutil.ReadAll(upload.File)
+			require.NoError(t, err)
+			require.Equal(t, "test1", string(content))
+
+			return graphql.OneShot(&graphql.Response{Data: []byte(`{"singleUpload":"test"}`)})
+		}
+
+		operations := `{ "query": "mutation ($file: Upload!) { singleUpload(file: $file) }", "variables": { "file": null } }`
+		mapData := `{ "0": ["variables.file"] }`
+		files := []file{
+			{
+				mapKey:      "0",
+				name:        "a.txt",
+				content:     "test1",
+				contentType: "text/plain",
+			},
+		}
+		resp := doFastHTTPRequest(t, h, createUploadRequest(t, operations, mapData, files))
+		require.Equal(t, http.StatusOK, resp.Response.StatusCode(), string(resp.Response.Body()))
+		require.Equal(t, `{"data":{"singleUpload":"test"}}`, string(resp.Response.Body()))
+	})
+
+	t.Run("valid file list upload", func(t *testing.T) {
+		es.ExecFunc = func(ctx context.Context) graphql.ResponseHandler {
+			op := graphql.GetOperationContext(ctx).Operation
+			require.Equal(t, len(op.VariableDefinitions), 1)
+			require.Equal(t, op.VariableDefinitions[0].Variable, "files")
+			return graphql.OneShot(&graphql.Response{Data: []byte(`{"multipleUpload":[{"id":1},{"id":2}]}`)})
+		}
+
+		operations := `{ "query": "mutation($files: [Upload!]!) { multipleUpload(files: $files) }", "variables": { "files": [null, null] } }`
+		mapData := `{ "0": ["variables.files.0"], "1": ["variables.files.1"] }`
+		files := []file{
+			{
+				mapKey:      "0",
+				name:        "a.txt",
+				content:     "test1",
+				contentType: "text/plain",
+			},
+			{
+				mapKey:      "1",
+				name:        "b.txt",
+				content:     "test2",
+				contentType: "text/plain",
+			},
+		}
+		resp := doFastHTTPRequest(t, h, createUploadRequest(t, operations, mapData, files))
+		require.Equal(t, http.StatusOK, resp.Response.StatusCode(), string(resp.Response.Body()))
+		require.Equal(t, `{"data":{"multipleUpload":[{"id":1},{"id":2}]}}`, string(resp.Response.Body()))
+	})
+
+	t.Run("valid file list upload with payload and file reuse", func(t *testing.T) {
+		test := func(uploadMaxMemory int64) {
+			es.ExecFunc = func(ctx context.Context) graphql.ResponseHandler {
+				op := graphql.GetOperationContext(ctx).Operation
+				require.Equal(t, len(op.VariableDefinitions), 1)
+				require.Equal(t, op.VariableDefinitions[0].Variable, "req")
+				return graphql.OneShot(&graphql.Response{Data: []byte(`{"multipleUploadWithPayload":[{"id":1},{"id":2}]}`)})
+			}
+			multipartForm.MaxMemory = uploadMaxMemory
+
+			operations := `{ "query": "mutation($req: [UploadFile!]!) { multipleUploadWithPayload(req: $req) }", "variables": { "req": [ { "id": 1, "file": null }, { "id": 2, "file": null } ] } }`
+			mapData := `{ "0": ["variables.req.0.file", "variables.req.1.file"] }`
+			files := []file{
+				{
+					mapKey:      "0",
+					name:        "a.txt",
+					content:     "test1",
+					contentType: "text/plain",
+				},
+			}
+			resp := doFastHTTPRequest(t, h, createUploadRequest(t, operations, mapData, files))
+			require.Equal(t, http.StatusOK, resp.Response.StatusCode(), string(resp.Response.Body()))
+			require.Equal(t, `{"data":{"multipleUploadWithPayload":[{"id":1},{"id":2}]}}`, string(resp.Response.Body()))
+		}
+
+		t.Run("payload smaller than UploadMaxMemory, stored in memory", func(t *testing.T) {
+			test(5000)
+		})
+
+		t.Run("payload bigger than UploadMaxMemory, persisted to disk", func(t *testing.T) {
+			test(2)
+		})
+	})
+
+	validOperations := `{ "query": "mutation ($file: Upload!) { singleUpload(file: $file) }", "variables": { "file": null } }`
+	validMap := `{ "0": ["variables.file"] }`
+	validFiles := []file{
+		{
+			mapKey:      "0",
+			name:        "a.txt",
+			content:     "test1",
+			contentType: "text/plain",
+		},
+	}
+
+	t.Run("failed to parse multipart", func(t *testing.T) {
+		resp := doFastRequestWithContentType(h, "POST", "/graphql", "", `multipart/form-data; boundary="foo123"`)
+		require.Equal(t, http.StatusUnprocessableEntity, resp.Response.StatusCode(), string(resp.Response.Body()))
+		require.Equal(t, `{"errors":[{"message":"failed to parse multipart form"}],"data":null}`, string(resp.Response.Body()))
+	})
+
+	t.Run("fail parse operation", func(t *testing.T) {
+		resp := doFastHTTPRequest(t, h, createUploadRequest(t, `invalid operation`, validMap, validFiles))
+		require.Equal(t, http.StatusUnprocessableEntity, resp.Response.StatusCode(), string(resp.Response.Body()))
+		require.Equal(t, `{"errors":[{"message":"operations form field could not be decoded"}],"data":null}`, string(resp.Response.Body()))
+	})
+
+	t.Run("fail parse map", func(t *testing.T) {
+		resp := doFastHTTPRequest(t, h, createUploadRequest(t, validOperations, `invalid map`, validFiles))
+		require.Equal(t, http.StatusUnprocessableEntity, resp.Response.StatusCode(), string(resp.Response.Body()))
+		require.Equal(t, `{"errors":[{"message":"map form field could not be decoded"}],"data":null}`, string(resp.Response.Body()))
+	})
+
+	t.Run("fail missing file", func(t *testing.T) {
+		resp := doFastHTTPRequest(t, h, createUploadRequest(t, validOperations, validMap, nil))
+		require.Equal(t, http.StatusUnprocessableEntity, resp.Response.StatusCode(), string(resp.Response.Body()))
+		require.Equal(t, `{"errors":[{"message":"failed to get key 0 from form"}],"data":null}`, string(resp.Response.Body()))
+	})
+
+	t.Run("fail map entry with invalid operations paths prefix", func(t *testing.T) {
+		resp := doFastHTTPRequest(t, h, createUploadRequest(t, validOperations, `{ "0": ["var.file"] }`, validFiles))
+		require.Equal(t, http.StatusUnprocessableEntity, resp.Response.StatusCode(), string(resp.Response.Body()))
+		require.Equal(t, `{"errors":[{"message":"invalid operations paths for key 0"}],"data":null}`, string(resp.Response.Body()))
+	})
+
+	t.Run("fail parse request big body", func(t *testing.T) {
+		multipartForm.MaxUploadSize = 2
+		resp := doFastHTTPRequest(t, h, createUploadRequest(t, validOperations, validMap, validFiles))
+		require.Equal(t, http.StatusUnprocessableEntity, resp.Response.StatusCode(), string(resp.Response.Body()))
+		require.Equal(t, `{"errors":[{"message":"failed to parse multipart form, request body too large"}],"data":null}`, string(resp.Response.Body()))
+	})
+}
@@ -1,22 +1,33 @@
 package transport
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/valyala/fasthttp"
 	"github.com/vektah/gqlparser/v2/ast"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 // GET implements the GET side of the default HTTP transport
 // defined in https://github.com/APIs-guru/graphql-over-http#get
-type GET struct{}
+type GET struct {
+	// Stream writes the data of responses to the client as it is marshaled, instead of buffering it first. It lowers
+	// the memory used by large responses, but response middlewares don't see their data.
+	Stream bool
+}
 
-var _ graphql.Transport = GET{}
+var (
+	_ graphql.Transport = GET{}
+	_ FastTransport     = GET{}
+)
 
 func (h GET) Supports(r *http.Request) bool {
 	if r.Header.Get("Upgrade") != "" {
@@ -26,37 +37,38 @@ func (h GET) Supports(r *http.Request) bool {
 	return r.Method == "GET"
 }
 
+func (h GET) SupportsFastHTTP(rctx *fasthttp.RequestCtx) bool {
+	if len(rctx.Request.Header.Peek("Upgrade")) != 0 {
+		return false
+	}
+
+	return rctx.IsGet()
+}
+
 func (h GET) Do(w http.ResponseWriter, r *http.Request, exec graphql.GraphExecutor) {
 	w.Header().Set("Content-Type", "application/json")
+	h.do(r.Context(), w, r.URL.Query(), r.Header, exec)
+}
 
-	raw := &graphql.RawParams{
-		Query:         r.URL.Query().Get("query"),
-		OperationName: r.URL.Query().Get("operationName"),
-	}
-	raw.ReadTime.Start = graphql.Now()
+func (h GET) DoFastHTTP(ctx context.Context, rctx *fasthttp.RequestCtx, exec graphql.GraphExecutor) {
+	rctx.SetContentType("application/json")
 
-	if variables := r.URL.Query().Get("variables"); variables != "" {
-		if err := jsonDecode(strings.NewReader(variables), &raw.Variables); err != nil {
-			w.WriteHeader(http.StatusBadRequest)
-			writeJsonError(w, "variables could not be decoded")
-			return
-		}
-	}
+	h.do(ctx, fastHTTPResponseWriter{rctx}, fastHTTPQuery(rctx), fastHTTPHeaders(rctx), exec)
+}
 
-	if extensions := r.URL.Query().Get("extensions"); extensions != "" {
-		if err := jsonDecode(strings.NewReader(extensions), &raw.Extensions); err != nil {
-			w.WriteHeader(http.StatusBadRequest)
-			writeJsonError(w, "extensions could not be decoded")
-			return
-		}
+func (h GET) do(ctx context.Context, w responseWriter, query url.Values, headers http.Header, exec graphql.GraphExecutor) {
+	raw, err := rawParamsFromQuery(query)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		writeJsonError(w, err.Error())
+		return
 	}
+	raw.Headers = headers
 
-	raw.ReadTime.End = graphql.Now()
-
-	rc, err := exec.CreateOperationContext(r.Context(), raw)
-	if err != nil {
-		w.WriteHeader(statusFor(err))
-		resp := exec.DispatchError(graphql.WithOperationContext(r.Context(), rc), err)
+	rc, gqlErr := exec.CreateOperationContext(ctx, raw)
+	if gqlErr != nil {
+		w.WriteHeader(statusFor(gqlErr))
+		resp := exec.DispatchError(graphql.WithOperationContext(ctx, rc), gqlErr)
 		writeJson(w, resp)
 		return
 	}
@@ -67,8 +79,35 @@ func (h GET) Do(w http.ResponseWriter, r *http.Request, exec graphql.GraphExecut
 		return
 	}
 
-	responses, ctx := exec.DispatchOperation(r.Context(), rc)
-	writeJson(w, responses(ctx))
+	rc.StreamData = h.Stream
+	responses, ctx := exec.DispatchOperation(ctx, rc)
+	response := responses(ctx)
+	setCacheControl(ctx, w, rc, response)
+	writeResponse(ctx, w, response)
+}
+
+// rawParamsFromQuery reads the parameters of an operation from the query string of a GET request.
+func rawParamsFromQuery(query url.Values) (*graphql.RawParams, error) {
+	raw := &graphql.RawParams{
+		Query:         query.Get("query"),
+		OperationName: query.Get("operationName"),
+	}
+	raw.ReadTime.Start = graphql.Now()
+
+	if variables := query.Get("variables"); variables != "" {
+		if err := jsonDecode(strings.NewReader(variables), &raw.Variables); err != nil {
+			return nil, errors.New("variables could not be decoded")
+		}
+	}
+
+	if extensions := query.Get("extensions"); extensions != "" {
+		if err := jsonDecode(strings.NewReader(extensions), &raw.Extensions); err != nil {
+			return nil, errors.New("extensions could not be decoded")
+		}
+	}
+
+	raw.ReadTime.End = graphql.Now()
+	return raw, nil
 }
 
 func jsonDecode(r io.Reader, val interface{}) error {
@@ -0,0 +1,50 @@
+package transport_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql/handler/testserver"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGETFastHTTP(t *testing.T) {
+	h := testserver.New()
+	h.AddTransport(transport.GET{})
+
+	t.Run("success", func(t *testing.T) {
+		resp := doFastRequest(h, "GET", "/graphql?query={name}", ``)
+		assert.Equal(t, http.StatusOK, resp.Response.StatusCode(), string(resp.Response.Body()))
+		assert.Equal(t, `{"data":{"name":"test"}}`, string(resp.Response.Body()))
+	})
+
+	t.Run("has json content-type header", func(t *testing.T) {
+		resp := doFastRequest(h, "GET", "/graphql?query={name}", ``)
+		assert.Equal(t, "application/json", string(resp.Response.Header.ContentType()))
+	})
+
+	t.Run("decode failure", func(t *testing.T) {
+		resp := doFastRequest(h, "GET", "/graphql?query={name}&variables=notjson", "")
+		assert.Equal(t, http.StatusBadRequest, resp.Response.StatusCode(), string(resp.Response.Body()))
+		assert.Equal(t, `{"errors":[{"message":"variables could not be decoded"}],"data":null}`, string(resp.Response.Body()))
+	})
+
+	t.Run("invalid variable", func(t *testing.T) {
+		resp := doFastRequest(h, "GET", `/graphql?query=query($id:Int!){find(id:$id)}&variables={"id":false}`, "")
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.Response.StatusCode(), string(resp.Response.Body()))
+		assert.Equal(t, `{"errors":[{"message":"cannot use bool as Int","path":["variable","id"],"extensions":{"code":"GRAPHQL_VALIDATION_FAILED"}}],"data":null}`, string(resp.Response.Body()))
+	})
+
+	t.Run("parse failure", func(t *testing.T) {
+		resp := doFastRequest(h, "GET", "/graphql?query=!", "")
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.Response.StatusCode(), string(resp.Response.Body()))
+		assert.Equal(t, `{"errors":[{"message":"Unexpected !","locations":[{"line":1,"column":1}],"extensions":{"code":"GRAPHQL_PARSE_FAILED"}}],"data":null}`, string(resp.Response.Body()))
+	})
+
+	t.Run("no mutations", func(t *testing.T) {
+		resp := doFastRequest(h, "GET", "/graphql?query=mutation{name}", "")
+		assert.Equal(t, http.StatusNotAcceptable, resp.Response.StatusCode(), string(resp.Response.Body()))
+		assert.Equal(t, `{"errors":[{"message":"GET requests only allow query operations"}],"data":null}`, string(resp.Response.Body()))
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/99designs/gqlgen/graphql/handler/extension"
 	"github.com/99designs/gqlgen/graphql/handler/testserver"
 	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/stretchr/testify/assert"
@@ -42,6 +43,18 @@ func TestGET(t *testing.T) {
 		assert.Equal(t, `{"errors":[{"message":"Unexpected !","locations":[{"line":1,"column":1}],"extensions":{"code":"GRAPHQL_PARSE_FAILED"}}],"data":null}`, resp.Body.String())
 	})
 
+	t.Run("cache control", func(t *testing.T) {
+		h := testserver.New()
+		h.AddTransport(transport.GET{})
+		h.Use(&extension.CacheControl{})
+
+		resp := doRequest(h, "GET", "/graphql?query={name}", ``)
+		assert.Equal(t, "max-age=60, public", resp.Header().Get("Cache-Control"))
+
+		resp = doRequest(h, "GET", "/graphql?query={name}&variables=[", ``)
+		assert.Equal(t, "", resp.Header().Get("Cache-Control"))
+	})
+
 	t.Run("no mutations", func(t *testing.T) {
 		resp := doRequest(h, "GET", "/graphql?query=mutation{name}", "")
 		assert.Equal(t, http.StatusNotAcceptable, resp.Code, resp.Body.String())
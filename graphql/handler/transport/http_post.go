@@ -1,53 +1,260 @@
 package transport
 
 import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
 	"mime"
 	"net/http"
+	"sync"
 
 	"github.com/99designs/gqlgen/graphql"
+	"github.com/valyala/fasthttp"
+	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 // POST implements the POST side of the default HTTP transport
 // defined in https://github.com/APIs-guru/graphql-over-http#post
-type POST struct{}
+//
+// A JSON array of operations is executed as a batch, and answered with the array of their responses in the same order.
+type POST struct {
+	// MaxBatchSize sets the maximum number of operations in a batch. Batches are rejected unless it is set.
+	MaxBatchSize int
 
-var _ graphql.Transport = POST{}
+	// Stream writes the data of responses to the client as it is marshaled, instead of buffering it first. It lowers
+	// the memory used by large responses, but response middlewares don't see their data. Batches and incremental
+	// responses are never streamed.
+	Stream bool
+}
+
+var (
+	_ graphql.Transport = POST{}
+	_ FastTransport     = POST{}
+)
 
 func (h POST) Supports(r *http.Request) bool {
 	if r.Header.Get("Upgrade") != "" {
 		return false
 	}
 
-	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	return r.Method == "POST" && isJSONMediaType(r.Header.Get("Content-Type"))
+}
+
+func (h POST) SupportsFastHTTP(rctx *fasthttp.RequestCtx) bool {
+	if len(rctx.Request.Header.Peek("Upgrade")) != 0 {
+		return false
+	}
+
+	return rctx.IsPost() && isJSONMediaType(string(rctx.Request.Header.ContentType()))
+}
+
+func isJSONMediaType(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
 	if err != nil {
 		return false
 	}
 
-	return r.Method == "POST" && mediaType == "application/json"
+	return mediaType == "application/json"
 }
 
 func (h POST) Do(w http.ResponseWriter, r *http.Request, exec graphql.GraphExecutor) {
 	w.Header().Set("Content-Type", "application/json")
+	response, responses, ctx := h.do(r.Context(), w, r.Body, r.Header, exec, acceptsMultipartMixed(r.Header.Get("Accept")))
+	if responses == nil {
+		return
+	}
 
-	var params *graphql.RawParams
+	w.Header().Set("Content-Type", multipartMixedContentType)
+	writeMultipartMixed(ctx, w, func() {
+		if f, ok := w.(http.Flusher); ok {
+			f.Flush()
+		}
+	}, response, responses)
+}
+
+func (h POST) DoFastHTTP(ctx context.Context, rctx *fasthttp.RequestCtx, exec graphql.GraphExecutor) {
+	rctx.SetContentType("application/json")
+
+	incremental := acceptsMultipartMixed(string(rctx.Request.Header.Peek("Accept")))
+	cancel := func() {}
+	if incremental {
+		// the rest of the response is written after the handler returns, once the request context has been recycled
+		ctx, cancel = context.WithCancel(WithDrainer(graphql.StartOperationTrace(detachFastHTTPContext(rctx)), GetDrainer(ctx)))
+	}
+
+	response, responses, ctx := h.do(ctx, fastHTTPResponseWriter{rctx}, bytes.NewReader(rctx.PostBody()), fastHTTPHeaders(rctx), exec, incremental)
+	if responses == nil {
+		cancel()
+		return
+	}
+
+	rctx.SetContentType(multipartMixedContentType)
+	drainer := GetDrainer(ctx)
+	drainer.Hold()
+	rctx.SetBodyStreamWriter(func(w *bufio.Writer) {
+		defer drainer.Release()
+		defer cancel()
+		writeMultipartMixed(ctx, w, func() {
+			if err := w.Flush(); err != nil {
+				cancel()
+			}
+		}, response, responses)
+	})
+}
+
+// do executes the operation in body. When incremental delivery is allowed and the operation deferred part of its
+// result, the initial response is returned along with the handler for the subsequent payloads instead of being
+// written.
+func (h POST) do(ctx context.Context, w responseWriter, body io.Reader, headers http.Header, exec graphql.GraphExecutor, incremental bool) (*graphql.Response, graphql.ResponseHandler, context.Context) {
+	r := bufio.NewReader(body)
+	if isBatch(r) {
+		h.doBatch(ctx, w, r, headers, exec)
+		return nil, nil, ctx
+	}
+
+	params, err := rawParamsFromBody(r)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		writeJsonError(w, err.Error())
+		return nil, nil, ctx
+	}
+	params.Headers = headers
+
+	rc, gqlErr := exec.CreateOperationContext(ctx, params)
+	if gqlErr != nil {
+		w.WriteHeader(statusFor(gqlErr))
+		resp := exec.DispatchError(graphql.WithOperationContext(ctx, rc), gqlErr)
+		writeJson(w, resp)
+		return nil, nil, ctx
+	}
+	rc.IncrementalDelivery = incremental
+	rc.StreamData = h.Stream && !incremental
+	responses, ctx := exec.DispatchOperation(ctx, rc)
+	response := responses(ctx)
+	if response != nil && response.HasNext != nil {
+		return response, responses, ctx
+	}
+	setCacheControl(ctx, w, rc, response)
+	writeResponse(ctx, w, response)
+	return nil, nil, ctx
+}
+
+// isBatch reports whether body is a JSON array, holding a batch of operations.
+func isBatch(body *bufio.Reader) bool {
+	for {
+		c, err := body.ReadByte()
+		if err != nil {
+			return false
+		}
+		switch c {
+		case ' ', '\t', '\r', '\n':
+			continue
+		}
+		_ = body.UnreadByte()
+		return c == '['
+	}
+}
+
+// doBatch executes the batch of operations in body concurrently, and writes the array of their responses. Errors are
+// reported in the response of the operation causing them, so the batch succeeds as long as it can be decoded.
+func (h POST) doBatch(ctx context.Context, w responseWriter, body io.Reader, headers http.Header, exec graphql.GraphExecutor) {
+	if h.MaxBatchSize <= 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		writeJsonError(w, "batched operations are not supported")
+		return
+	}
+
+	var batch []*graphql.RawParams
 	start := graphql.Now()
-	if err := jsonDecode(r.Body, &params); err != nil {
+	if err := jsonDecode(body, &batch); err != nil {
 		w.WriteHeader(http.StatusBadRequest)
-		writeJsonErrorf(w, "json body could not be decoded: "+err.Error())
+		writeJsonErrorf(w, "json body could not be decoded: %s", err.Error())
 		return
 	}
-	params.ReadTime = graphql.TraceTiming{
+	if len(batch) == 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		writeJsonError(w, "the batch must contain at least one operation")
+		return
+	}
+	if len(batch) > h.MaxBatchSize {
+		w.WriteHeader(http.StatusBadRequest)
+		writeJsonErrorf(w, "the batch contains %d operations, more than the maximum of %d", len(batch), h.MaxBatchSize)
+		return
+	}
+	readTime := graphql.TraceTiming{
 		Start: start,
 		End:   graphql.Now(),
 	}
 
-	rc, err := exec.CreateOperationContext(r.Context(), params)
+	responses := make([]*graphql.Response, len(batch))
+	var wg sync.WaitGroup
+	wg.Add(len(batch))
+	for i, params := range batch {
+		go func(i int, params *graphql.RawParams) {
+			defer wg.Done()
+			defer func() {
+				// the operations don't run in the goroutine of the request, so their panics must not escape
+				if r := recover(); r != nil {
+					responses[i] = &graphql.Response{Errors: gqlerror.List{presentRecoveredError(ctx, exec, r)}}
+				}
+			}()
+
+			if params == nil {
+				responses[i] = &graphql.Response{Errors: gqlerror.List{{Message: "operations must be objects"}}}
+				return
+			}
+			params.ReadTime = readTime
+			params.Headers = headers
+			params.Batch = &graphql.BatchStats{Index: i, Size: len(batch)}
+			responses[i] = executeBatched(graphql.StartOperationTrace(ctx), params, exec)
+		}(i, params)
+	}
+	wg.Wait()
+
+	b, err := json.Marshal(responses)
 	if err != nil {
-		w.WriteHeader(statusFor(err))
-		resp := exec.DispatchError(graphql.WithOperationContext(r.Context(), rc), err)
-		writeJson(w, resp)
-		return
+		panic(err)
+	}
+	w.Write(b)
+}
+
+// recoveredErrorPresenter is implemented by executors presenting the panics they recover from, like the executor
+// of the handler package.
+type recoveredErrorPresenter interface {
+	PresentRecoveredError(ctx context.Context, err interface{}) *gqlerror.Error
+}
+
+func presentRecoveredError(ctx context.Context, exec graphql.GraphExecutor, r interface{}) *gqlerror.Error {
+	if p, ok := exec.(recoveredErrorPresenter); ok {
+		return p.PresentRecoveredError(ctx, r)
+	}
+	return &gqlerror.Error{Message: "internal system error"}
+}
+
+// executeBatched executes an operation of a batch. Incremental delivery is not supported in batches, so deferred
+// fragments are part of its only response.
+func executeBatched(ctx context.Context, params *graphql.RawParams, exec graphql.GraphExecutor) *graphql.Response {
+	rc, gqlErr := exec.CreateOperationContext(ctx, params)
+	if gqlErr != nil {
+		return exec.DispatchError(graphql.WithOperationContext(ctx, rc), gqlErr)
+	}
+	responses, ctx := exec.DispatchOperation(ctx, rc)
+	return responses(ctx)
+}
+
+// rawParamsFromBody reads the parameters of an operation from the JSON body of a POST request.
+func rawParamsFromBody(body io.Reader) (*graphql.RawParams, error) {
+	var params *graphql.RawParams
+	start := graphql.Now()
+	if err := jsonDecode(body, &params); err != nil {
+		return nil, fmt.Errorf("json body could not be decoded: %s", err.Error())
+	}
+	params.ReadTime = graphql.TraceTiming{
+		Start: start,
+		End:   graphql.Now(),
 	}
-	responses, ctx := exec.DispatchOperation(r.Context(), rc)
-	writeJson(w, responses(ctx))
+	return params, nil
 }
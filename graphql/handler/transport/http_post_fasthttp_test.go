@@ -0,0 +1,149 @@
+package transport_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/testserver"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/valyala/fasthttp"
+)
+
+func TestPOSTFastHTTP(t *testing.T) {
+	h := testserver.New()
+	h.AddTransport(transport.POST{})
+
+	t.Run("success", func(t *testing.T) {
+		resp := doFastRequest(h, "POST", "/graphql", `{"query":"{ name }"}`)
+		assert.Equal(t, http.StatusOK, resp.Response.StatusCode())
+		assert.Equal(t, `{"data":{"name":"test"}}`, string(resp.Response.Body()))
+	})
+
+	t.Run("decode failure", func(t *testing.T) {
+		resp := doFastRequest(h, "POST", "/graphql", "notjson")
+		assert.Equal(t, http.StatusBadRequest, resp.Response.StatusCode(), string(resp.Response.Body()))
+		assert.Equal(t, "application/json", string(resp.Response.Header.ContentType()))
+		assert.Equal(t, `{"errors":[{"message":"json body could not be decoded: invalid character 'o' in literal null (expecting 'u')"}],"data":null}`, string(resp.Response.Body()))
+	})
+
+	t.Run("parse failure", func(t *testing.T) {
+		resp := doFastRequest(h, "POST", "/graphql", `{"query": "!"}`)
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.Response.StatusCode(), string(resp.Response.Body()))
+		assert.Equal(t, "application/json", string(resp.Response.Header.ContentType()))
+		assert.Equal(t, `{"errors":[{"message":"Unexpected !","locations":[{"line":1,"column":1}],"extensions":{"code":"GRAPHQL_PARSE_FAILED"}}],"data":null}`, string(resp.Response.Body()))
+	})
+
+	t.Run("batch", func(t *testing.T) {
+		h := testserver.New()
+		h.AddTransport(transport.POST{MaxBatchSize: 10})
+
+		resp := doFastRequest(h, "POST", "/graphql", `[{"query":"{ name }"}, {"query": "!"}]`)
+		assert.Equal(t, http.StatusOK, resp.Response.StatusCode(), string(resp.Response.Body()))
+		assert.Equal(t, "application/json", string(resp.Response.Header.ContentType()))
+		assert.Equal(t, `[{"data":{"name":"test"}},{"errors":[{"message":"Unexpected !","locations":[{"line":1,"column":1}],"extensions":{"code":"GRAPHQL_PARSE_FAILED"}}],"data":null}]`, string(resp.Response.Body()))
+	})
+
+	t.Run("request headers", func(t *testing.T) {
+		h := testserver.New()
+		h.AddTransport(transport.POST{})
+		var headers http.Header
+		h.AroundOperations(func(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+			headers = graphql.GetOperationContext(ctx).Headers
+			return next(ctx)
+		})
+
+		var req fasthttp.Request
+		req.Header.SetMethod("POST")
+		req.SetRequestURI("/graphql")
+		req.SetBodyString(`{"query":"{ name }"}`)
+		req.Header.SetContentType("application/json")
+		req.Header.Set("X-Client", "test")
+		var rctx fasthttp.RequestCtx
+		rctx.Init(&req, nil, nil)
+		h.ServeFastHTTP(&rctx)
+		assert.Equal(t, "test", headers.Get("X-Client"))
+	})
+
+	t.Run("cache control", func(t *testing.T) {
+		h := testserver.New()
+		h.AddTransport(transport.POST{})
+		h.Use(&extension.CacheControl{})
+
+		resp := doFastRequest(h, "POST", "/graphql", `{"query":"{ name }"}`)
+		assert.Equal(t, "max-age=60, public", string(resp.Response.Header.Peek("Cache-Control")))
+	})
+
+	t.Run("incremental delivery", func(t *testing.T) {
+		var req fasthttp.Request
+		req.Header.SetMethod("POST")
+		req.SetRequestURI("/graphql")
+		req.SetBodyString(`{"query":"{ ... @defer(label: \"name\") { name } }"}`)
+		req.Header.SetContentType("application/json")
+		req.Header.Set("Accept", "multipart/mixed; deferSpec=20220824, application/json")
+
+		var rctx fasthttp.RequestCtx
+		rctx.Init(&req, nil, nil)
+		h.ServeFastHTTP(&rctx)
+
+		assert.Equal(t, http.StatusOK, rctx.Response.StatusCode())
+		assert.Equal(t, `multipart/mixed; boundary="-"; deferSpec=20220824`, string(rctx.Response.Header.ContentType()))
+		assert.Equal(t, "\r\n---\r\nContent-Type: application/json; charset=utf-8\r\n\r\n"+
+			`{"data":{},"hasNext":true}`+
+			"\r\n---\r\nContent-Type: application/json; charset=utf-8\r\n\r\n"+
+			`{"incremental":[{"data":{"name":"test"},"path":[],"label":"name"}],"hasNext":false}`+
+			"\r\n-----\r\n", string(rctx.Response.Body()))
+	})
+
+	t.Run("validation failure", func(t *testing.T) {
+		resp := doFastRequest(h, "POST", "/graphql", `{"query": "{ title }"}`)
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.Response.StatusCode(), string(resp.Response.Body()))
+		assert.Equal(t, `{"errors":[{"message":"Cannot query field \"title\" on type \"Query\".","locations":[{"line":1,"column":3}],"extensions":{"code":"GRAPHQL_VALIDATION_FAILED"}}],"data":null}`, string(resp.Response.Body()))
+	})
+
+	t.Run("invalid variable", func(t *testing.T) {
+		resp := doFastRequest(h, "POST", "/graphql", `{"query": "query($id:Int!){find(id:$id)}","variables":{"id":false}}`)
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.Response.StatusCode(), string(resp.Response.Body()))
+		assert.Equal(t, `{"errors":[{"message":"cannot use bool as Int","path":["variable","id"],"extensions":{"code":"GRAPHQL_VALIDATION_FAILED"}}],"data":null}`, string(resp.Response.Body()))
+	})
+
+	t.Run("execution failure", func(t *testing.T) {
+		resp := doFastRequest(h, "POST", "/graphql", `{"query": "mutation { name }"}`)
+		assert.Equal(t, http.StatusOK, resp.Response.StatusCode(), string(resp.Response.Body()))
+		assert.Equal(t, `{"errors":[{"message":"mutations are not supported"}],"data":null}`, string(resp.Response.Body()))
+	})
+
+	t.Run("validate content type", func(t *testing.T) {
+		validContentTypes := []string{
+			"application/json",
+			"application/json; charset=utf-8",
+		}
+
+		for _, contentType := range validContentTypes {
+			t.Run(fmt.Sprintf("allow for content type %s", contentType), func(t *testing.T) {
+				resp := doFastRequestWithContentType(h, "POST", "/graphql", `{"query":"{ name }"}`, contentType)
+				assert.Equal(t, http.StatusOK, resp.Response.StatusCode(), string(resp.Response.Body()))
+				assert.Equal(t, `{"data":{"name":"test"}}`, string(resp.Response.Body()))
+			})
+		}
+
+		invalidContentTypes := []string{
+			"",
+			"text/plain",
+			"application/x-www-form-urlencoded",
+			"application/graphql",
+		}
+
+		for _, tc := range invalidContentTypes {
+			t.Run(fmt.Sprintf("reject for content type %s", tc), func(t *testing.T) {
+				resp := doFastRequestWithContentType(h, "POST", "/graphql", `{"query":"{ name }"}`, tc)
+				assert.Equal(t, http.StatusBadRequest, resp.Response.StatusCode(), string(resp.Response.Body()))
+				assert.Equal(t, `{"errors":[{"message":"transport not supported"}],"data":null}`, string(resp.Response.Body()))
+			})
+		}
+	})
+}
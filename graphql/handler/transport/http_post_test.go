@@ -1,12 +1,16 @@
 package transport_test
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"sync"
 	"testing"
 
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
 	"github.com/99designs/gqlgen/graphql/handler/testserver"
 	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/stretchr/testify/assert"
@@ -57,6 +61,178 @@ func TestPOST(t *testing.T) {
 		assert.Equal(t, `{"errors":[{"message":"mutations are not supported"}],"data":null}`, resp.Body.String())
 	})
 
+	t.Run("request headers", func(t *testing.T) {
+		h := testserver.New()
+		h.AddTransport(transport.POST{})
+		var headers http.Header
+		h.AroundOperations(func(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+			headers = graphql.GetOperationContext(ctx).Headers
+			return next(ctx)
+		})
+
+		r := httptest.NewRequest("POST", "/graphql", strings.NewReader(`{"query":"{ name }"}`))
+		r.Header.Set("Content-Type", "application/json")
+		r.Header.Set("X-Client", "test")
+		h.ServeHTTP(httptest.NewRecorder(), r)
+		assert.Equal(t, "test", headers.Get("X-Client"))
+	})
+
+	t.Run("cache control", func(t *testing.T) {
+		h := testserver.New()
+		h.AddTransport(transport.POST{})
+		h.Use(&extension.CacheControl{})
+
+		resp := doRequest(h, "POST", "/graphql", `{"query":"{ name }"}`)
+		assert.Equal(t, "max-age=60, public", resp.Header().Get("Cache-Control"))
+
+		resp = doRequest(h, "POST", "/graphql", `{"query":"mutation { name }"}`)
+		assert.Equal(t, "", resp.Header().Get("Cache-Control"))
+
+		resp = doRequest(testserver.New(), "POST", "/graphql", `{"query":"{ name }"}`)
+		assert.Equal(t, "", resp.Header().Get("Cache-Control"), "without the extension")
+	})
+
+	t.Run("incremental delivery", func(t *testing.T) {
+		doIncrementalReq := func(body string, accept string) *httptest.ResponseRecorder {
+			r := httptest.NewRequest("POST", "/graphql", strings.NewReader(body))
+			r.Header.Set("Content-Type", "application/json")
+			r.Header.Set("Accept", accept)
+			w := httptest.NewRecorder()
+
+			h.ServeHTTP(w, r)
+			return w
+		}
+
+		t.Run("sends deferred payloads as multipart/mixed", func(t *testing.T) {
+			resp := doIncrementalReq(`{"query":"{ ... @defer(label: \"name\") { name } }"}`, "multipart/mixed; deferSpec=20220824, application/json")
+			assert.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+			assert.Equal(t, `multipart/mixed; boundary="-"; deferSpec=20220824`, resp.Header().Get("Content-Type"))
+			assert.Equal(t, "\r\n---\r\nContent-Type: application/json; charset=utf-8\r\n\r\n"+
+				`{"data":{},"hasNext":true}`+
+				"\r\n---\r\nContent-Type: application/json; charset=utf-8\r\n\r\n"+
+				`{"incremental":[{"data":{"name":"test"},"path":[],"label":"name"}],"hasNext":false}`+
+				"\r\n-----\r\n", resp.Body.String())
+		})
+
+		t.Run("sends a single json response when nothing is deferred", func(t *testing.T) {
+			resp := doIncrementalReq(`{"query":"{ name }"}`, "multipart/mixed, application/json")
+			assert.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+			assert.Equal(t, "application/json", resp.Header().Get("Content-Type"))
+			assert.Equal(t, `{"data":{"name":"test"}}`, resp.Body.String())
+		})
+
+		t.Run("ignores @defer unless multipart/mixed is accepted", func(t *testing.T) {
+			resp := doIncrementalReq(`{"query":"{ ... @defer { name } }"}`, "application/json")
+			assert.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+			assert.Equal(t, "application/json", resp.Header().Get("Content-Type"))
+			assert.Equal(t, `{"data":{"name":"test"}}`, resp.Body.String())
+		})
+	})
+
+	t.Run("batch", func(t *testing.T) {
+		h := testserver.New()
+		h.AddTransport(transport.POST{MaxBatchSize: 10})
+
+		t.Run("responds in the order of the operations", func(t *testing.T) {
+			resp := doRequest(h, "POST", "/graphql", ` [{"query":"{ name }"}, {"query":"mutation { name }"}, {"query":"{ title }"}, null]`)
+			assert.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+			assert.Equal(t, "application/json", resp.Header().Get("Content-Type"))
+			assert.Equal(t, `[{"data":{"name":"test"}},`+
+				`{"errors":[{"message":"mutations are not supported"}],"data":null},`+
+				`{"errors":[{"message":"Cannot query field \"title\" on type \"Query\".","locations":[{"line":1,"column":3}],"extensions":{"code":"GRAPHQL_VALIDATION_FAILED"}}],"data":null},`+
+				`{"errors":[{"message":"operations must be objects"}],"data":null}]`, resp.Body.String())
+		})
+
+		t.Run("decode failure", func(t *testing.T) {
+			resp := doRequest(h, "POST", "/graphql", `[{"query":"{ name }"}, 1]`)
+			assert.Equal(t, http.StatusBadRequest, resp.Code, resp.Body.String())
+			assert.Contains(t, resp.Body.String(), `{"errors":[{"message":"json body could not be decoded: json: cannot unmarshal number`)
+		})
+
+		t.Run("empty", func(t *testing.T) {
+			resp := doRequest(h, "POST", "/graphql", `[]`)
+			assert.Equal(t, http.StatusBadRequest, resp.Code, resp.Body.String())
+			assert.Equal(t, `{"errors":[{"message":"the batch must contain at least one operation"}],"data":null}`, resp.Body.String())
+		})
+
+		t.Run("too many operations", func(t *testing.T) {
+			h := testserver.New()
+			h.AddTransport(transport.POST{MaxBatchSize: 2})
+
+			resp := doRequest(h, "POST", "/graphql", `[{"query":"{ name }"}, {"query":"{ name }"}]`)
+			assert.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+			assert.Equal(t, `[{"data":{"name":"test"}},{"data":{"name":"test"}}]`, resp.Body.String())
+
+			resp = doRequest(h, "POST", "/graphql", `[{"query":"{ name }"}, {"query":"{ name }"}, {"query":"{ name }"}]`)
+			assert.Equal(t, http.StatusBadRequest, resp.Code, resp.Body.String())
+			assert.Equal(t, `{"errors":[{"message":"the batch contains 3 operations, more than the maximum of 2"}],"data":null}`, resp.Body.String())
+		})
+
+		t.Run("disabled", func(t *testing.T) {
+			h := testserver.New()
+			h.AddTransport(transport.POST{})
+
+			resp := doRequest(h, "POST", "/graphql", `[{"query":"{ name }"}]`)
+			assert.Equal(t, http.StatusBadRequest, resp.Code, resp.Body.String())
+			assert.Equal(t, `{"errors":[{"message":"batched operations are not supported"}],"data":null}`, resp.Body.String())
+		})
+
+		t.Run("panics", func(t *testing.T) {
+			h := testserver.New()
+			h.AddTransport(transport.POST{MaxBatchSize: 10})
+			h.SetRecoverFunc(func(ctx context.Context, err interface{}) error {
+				return fmt.Errorf("recovered: %v", err)
+			})
+			h.AroundOperations(func(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+				if graphql.GetOperationContext(ctx).Operation.Name == "B" {
+					panic("boom")
+				}
+				return next(ctx)
+			})
+
+			resp := doRequest(h, "POST", "/graphql", `[{"query":"query A { name }"}, {"query":"query B { name }"}]`)
+			assert.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+			assert.Equal(t, `[{"data":{"name":"test"}},{"errors":[{"message":"recovered: boom"}],"data":null}]`, resp.Body.String())
+		})
+
+		t.Run("stats", func(t *testing.T) {
+			h := testserver.New()
+			h.AddTransport(transport.POST{MaxBatchSize: 10})
+			var mu sync.Mutex
+			batches := map[string]*graphql.BatchStats{}
+			h.AroundOperations(func(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+				rc := graphql.GetOperationContext(ctx)
+				mu.Lock()
+				batches[rc.Operation.Name] = rc.Stats.Batch
+				mu.Unlock()
+				assert.False(t, rc.Stats.Read.Start.IsZero())
+				return next(ctx)
+			})
+
+			doRequest(h, "POST", "/graphql", `{"query":"query A { name }"}`)
+			assert.Equal(t, map[string]*graphql.BatchStats{"A": nil}, batches)
+
+			batches = map[string]*graphql.BatchStats{}
+			doRequest(h, "POST", "/graphql", `[{"query":"query A { name }"}, {"query":"query B { name }"}]`)
+			assert.Equal(t, map[string]*graphql.BatchStats{
+				"A": {Index: 0, Size: 2},
+				"B": {Index: 1, Size: 2},
+			}, batches)
+		})
+
+		t.Run("ignores @defer", func(t *testing.T) {
+			r := httptest.NewRequest("POST", "/graphql", strings.NewReader(`[{"query":"{ ... @defer { name } }"}]`))
+			r.Header.Set("Content-Type", "application/json")
+			r.Header.Set("Accept", "multipart/mixed, application/json")
+			w := httptest.NewRecorder()
+
+			h.ServeHTTP(w, r)
+			assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+			assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+			assert.Equal(t, `[{"data":{"name":"test"}}]`, w.Body.String())
+		})
+	})
+
 	t.Run("validate content type", func(t *testing.T) {
 		doReq := func(handler http.Handler, method string, target string, body string, contentType string) *httptest.ResponseRecorder {
 			r := httptest.NewRequest(method, target, strings.NewReader(body))
@@ -0,0 +1,40 @@
+package transport
+
+import (
+	"context"
+	"io"
+	"mime"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// multipartMixedContentType is the content type of responses using incremental delivery over HTTP, as described in
+// https://github.com/graphql/graphql-over-http/blob/main/rfcs/IncrementalDelivery.md
+const multipartMixedContentType = `multipart/mixed; boundary="-"; deferSpec=20220824`
+
+// acceptsMultipartMixed returns true if the Accept header of a request allows the response to be sent as
+// multipart/mixed, which enables @defer and @stream.
+func acceptsMultipartMixed(accept string) bool {
+	for _, mediaRange := range strings.Split(accept, ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(mediaRange))
+		if err == nil && mediaType == "multipart/mixed" {
+			return true
+		}
+	}
+	return false
+}
+
+// writeMultipartMixed writes the initial response of an operation and every subsequent payload as a part of a
+// multipart/mixed body, flushing after each part so the client can render them as they arrive.
+func writeMultipartMixed(ctx context.Context, w io.Writer, flush func(), response *graphql.Response, responses graphql.ResponseHandler) {
+	for response != nil {
+		io.WriteString(w, "\r\n---\r\nContent-Type: application/json; charset=utf-8\r\n\r\n")
+		writeJson(w, response)
+		flush()
+
+		response = responses(ctx)
+	}
+	io.WriteString(w, "\r\n-----\r\n")
+	flush()
+}
@@ -1,15 +1,20 @@
 package transport
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/99designs/gqlgen/graphql"
+	"github.com/valyala/fasthttp"
 )
 
 // Options responds to http OPTIONS and HEAD requests
 type Options struct{}
 
-var _ graphql.Transport = Options{}
+var (
+	_ graphql.Transport = Options{}
+	_ FastTransport     = Options{}
+)
 
 func (o Options) Supports(r *http.Request) bool {
 	return r.Method == "HEAD" || r.Method == "OPTIONS"
@@ -24,3 +29,17 @@ func (o Options) Do(w http.ResponseWriter, r *http.Request, exec graphql.GraphEx
 		w.WriteHeader(http.StatusMethodNotAllowed)
 	}
 }
+
+func (o Options) SupportsFastHTTP(rctx *fasthttp.RequestCtx) bool {
+	return rctx.IsHead() || rctx.IsOptions()
+}
+
+func (o Options) DoFastHTTP(ctx context.Context, rctx *fasthttp.RequestCtx, exec graphql.GraphExecutor) {
+	switch {
+	case rctx.IsOptions():
+		rctx.Response.Header.Set("Allow", "OPTIONS, GET, POST")
+		rctx.SetStatusCode(http.StatusOK)
+	case rctx.IsHead():
+		rctx.SetStatusCode(http.StatusMethodNotAllowed)
+	}
+}
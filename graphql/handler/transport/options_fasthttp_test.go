@@ -0,0 +1,26 @@
+package transport_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql/handler/testserver"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOptionsFastHTTP(t *testing.T) {
+	h := testserver.New()
+	h.AddTransport(transport.Options{})
+
+	t.Run("responds to options requests", func(t *testing.T) {
+		resp := doFastRequest(h, "OPTIONS", "/graphql?query={me{name}}", ``)
+		assert.Equal(t, http.StatusOK, resp.Response.StatusCode())
+		assert.Equal(t, "OPTIONS, GET, POST", string(resp.Response.Header.Peek("Allow")))
+	})
+
+	t.Run("responds to head requests", func(t *testing.T) {
+		resp := doFastRequest(h, "HEAD", "/graphql?query={me{name}}", ``)
+		assert.Equal(t, http.StatusMethodNotAllowed, resp.Response.StatusCode())
+	})
+}
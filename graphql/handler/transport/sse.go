@@ -0,0 +1,217 @@
+package transport
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/valyala/fasthttp"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// SSE implements the "distinct connections" mode of the GraphQL over Server-Sent Events protocol, defined in
+// https://github.com/enisdenjo/graphql-sse/blob/master/PROTOCOL.md
+//
+// Every response of the operation is sent as a next event, followed by a complete event once the operation is done.
+// It must be added before the GET and POST transports, which would otherwise handle the request.
+type SSE struct {
+	// KeepAlivePingInterval is the interval at which a comment is sent to keep idle connections open. Keep-alive
+	// comments are not sent if it is zero.
+	KeepAlivePingInterval time.Duration
+}
+
+var (
+	_ graphql.Transport = SSE{}
+	_ FastTransport     = SSE{}
+)
+
+func (t SSE) Supports(r *http.Request) bool {
+	if r.Header.Get("Upgrade") != "" {
+		return false
+	}
+
+	return acceptsEventStream(r.Header.Get("Accept")) && supportsSSEMethod(r.Method, r.Header.Get("Content-Type"))
+}
+
+func (t SSE) SupportsFastHTTP(rctx *fasthttp.RequestCtx) bool {
+	if len(rctx.Request.Header.Peek("Upgrade")) != 0 {
+		return false
+	}
+
+	return acceptsEventStream(string(rctx.Request.Header.Peek("Accept"))) &&
+		supportsSSEMethod(string(rctx.Method()), string(rctx.Request.Header.ContentType()))
+}
+
+func acceptsEventStream(accept string) bool {
+	for _, mediaRange := range strings.Split(accept, ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(mediaRange))
+		if err == nil && mediaType == "text/event-stream" {
+			return true
+		}
+	}
+	return false
+}
+
+func supportsSSEMethod(method string, contentType string) bool {
+	return method == "GET" || method == "POST" && isJSONMediaType(contentType)
+}
+
+func (t SSE) Do(w http.ResponseWriter, r *http.Request, exec graphql.GraphExecutor) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		SendErrorf(w, http.StatusInternalServerError, "streaming unsupported")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	var params *graphql.RawParams
+	var err error
+	if r.Method == "GET" {
+		params, err = rawParamsFromQuery(r.URL.Query())
+	} else {
+		params, err = rawParamsFromBody(r.Body)
+	}
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		writeJsonError(w, err.Error())
+		return
+	}
+	params.Headers = r.Header
+
+	// subscriptions end when the server shuts down
+	ctx, cancel := cancelOnShutdown(r.Context())
+	defer cancel()
+
+	responses, ctx := t.dispatch(ctx, w, params, r.Method, exec)
+	if responses == nil {
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+
+	writeEventStream(ctx, w, flusher.Flush, responses, t.KeepAlivePingInterval)
+}
+
+func (t SSE) DoFastHTTP(ctx context.Context, rctx *fasthttp.RequestCtx, exec graphql.GraphExecutor) {
+	rctx.SetContentType("application/json")
+
+	var params *graphql.RawParams
+	var err error
+	if rctx.IsGet() {
+		params, err = rawParamsFromQuery(fastHTTPQuery(rctx))
+	} else {
+		params, err = rawParamsFromBody(bytes.NewReader(rctx.PostBody()))
+	}
+	if err != nil {
+		rctx.SetStatusCode(http.StatusBadRequest)
+		writeJsonError(rctx, err.Error())
+		return
+	}
+	params.Headers = fastHTTPHeaders(rctx)
+
+	// events are written after the handler returns, once the request context has been recycled, and subscriptions end
+	// when the server shuts down
+	drainer := GetDrainer(ctx)
+	ctx, cancel := cancelOnShutdown(WithDrainer(graphql.StartOperationTrace(detachFastHTTPContext(rctx)), drainer))
+	responses, ctx := t.dispatch(ctx, fastHTTPResponseWriter{rctx}, params, string(rctx.Method()), exec)
+	if responses == nil {
+		cancel()
+		return
+	}
+
+	rctx.SetContentType("text/event-stream")
+	rctx.Response.Header.Set("Cache-Control", "no-cache")
+	drainer.Hold()
+	rctx.SetBodyStreamWriter(func(w *bufio.Writer) {
+		defer drainer.Release()
+		defer cancel()
+		writeEventStream(ctx, w, func() {
+			if err := w.Flush(); err != nil {
+				cancel()
+			}
+		}, responses, t.KeepAlivePingInterval)
+	})
+}
+
+// dispatch starts the operation described by params. Operations that cannot be executed are answered with a regular
+// JSON response, and a nil ResponseHandler is returned.
+func (t SSE) dispatch(ctx context.Context, w responseWriter, params *graphql.RawParams, method string, exec graphql.GraphExecutor) (graphql.ResponseHandler, context.Context) {
+	rc, gqlErr := exec.CreateOperationContext(ctx, params)
+	if gqlErr != nil {
+		w.WriteHeader(statusFor(gqlErr))
+		resp := exec.DispatchError(graphql.WithOperationContext(ctx, rc), gqlErr)
+		writeJson(w, resp)
+		return nil, ctx
+	}
+	if method == "GET" && rc.Operation.Operation == ast.Mutation {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		writeJsonError(w, "GET requests do not allow mutation operations")
+		return nil, ctx
+	}
+
+	rc.IncrementalDelivery = true
+	return exec.DispatchOperation(ctx, rc)
+}
+
+// writeEventStream writes every response of an operation as a next event, followed by a complete event. While
+// waiting for the next response, a keep-alive comment is written every keepAlive.
+func writeEventStream(ctx context.Context, w io.Writer, flush func(), responses graphql.ResponseHandler, keepAlive time.Duration) {
+	var mu sync.Mutex
+	write := func(event string) {
+		mu.Lock()
+		defer mu.Unlock()
+		io.WriteString(w, event)
+		flush()
+	}
+
+	// an empty comment makes sure the headers reach the client before the first response is ready
+	write(":\n\n")
+
+	if keepAlive != 0 {
+		ticker := time.NewTicker(keepAlive)
+		done := make(chan struct{})
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-ticker.C:
+					write(":\n\n")
+				case <-done:
+					return
+				}
+			}
+		}()
+		defer func() {
+			ticker.Stop()
+			close(done)
+			wg.Wait()
+		}()
+	}
+
+	for {
+		response := responses(ctx)
+		if response == nil {
+			break
+		}
+
+		b, err := json.Marshal(response)
+		if err != nil {
+			panic(err)
+		}
+		write("event: next\ndata: " + string(b) + "\n\n")
+	}
+	write("event: complete\ndata: \n\n")
+}
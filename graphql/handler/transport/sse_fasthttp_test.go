@@ -0,0 +1,82 @@
+package transport_test
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql/handler/testserver"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSSEFastHTTP(t *testing.T) {
+	h := testserver.New()
+	h.AddTransport(transport.SSE{})
+	h.AddTransport(transport.POST{})
+
+	url, closeServer := serveFastHTTP(t, h)
+	defer closeServer()
+
+	sseRequest := func(t *testing.T, body string) *http.Response {
+		r, err := http.NewRequest("POST", url, strings.NewReader(body))
+		require.NoError(t, err)
+		r.Header.Set("Content-Type", "application/json")
+		r.Header.Set("Accept", "text/event-stream")
+		resp, err := http.DefaultClient.Do(r)
+		require.NoError(t, err)
+		return resp
+	}
+
+	t.Run("query", func(t *testing.T) {
+		resp := sseRequest(t, `{"query":"{ name }"}`)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+		events := bufio.NewReader(resp.Body)
+		requireEvent(t, events, ":\n\n")
+		requireEvent(t, events, "event: next\ndata: {\"data\":{\"name\":\"test\"}}\n\n")
+		requireEvent(t, events, "event: complete\ndata: \n\n")
+	})
+
+	t.Run("decode failure", func(t *testing.T) {
+		resp := sseRequest(t, "notjson")
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+	})
+
+	t.Run("subscription", func(t *testing.T) {
+		resp := sseRequest(t, `{"query":"subscription { name }"}`)
+		defer resp.Body.Close()
+
+		events := bufio.NewReader(resp.Body)
+		requireEvent(t, events, ":\n\n")
+		for i := 0; i < 2; i++ {
+			h.SendNextSubscriptionMessage()
+			requireEvent(t, events, "event: next\ndata: {\"data\":{\"name\":\"test\"}}\n\n")
+		}
+		h.SendCompleteSubscriptionMessage()
+		requireEvent(t, events, "event: complete\ndata: \n\n")
+	})
+	t.Run("shutdown completes the subscription", func(t *testing.T) {
+		resp := sseRequest(t, `{"query":"subscription { name }"}`)
+		defer resp.Body.Close()
+
+		events := bufio.NewReader(resp.Body)
+		requireEvent(t, events, ":\n\n")
+		h.SendNextSubscriptionMessage()
+		requireEvent(t, events, "event: next\ndata: {\"data\":{\"name\":\"test\"}}\n\n")
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		require.NoError(t, h.Shutdown(ctx))
+		requireEvent(t, events, "event: complete\ndata: \n\n")
+	})
+}
@@ -0,0 +1,215 @@
+package transport_test
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql/handler/testserver"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSSE(t *testing.T) {
+	initialize := func() *testserver.TestServer {
+		h := testserver.New()
+		h.AddTransport(transport.SSE{})
+		h.AddTransport(transport.GET{})
+		h.AddTransport(transport.POST{})
+		return h
+	}
+
+	sseRequest := func(method string, target string, body string) *http.Request {
+		r := httptest.NewRequest(method, target, strings.NewReader(body))
+		r.Header.Set("Content-Type", "application/json")
+		r.Header.Set("Accept", "text/event-stream")
+		return r
+	}
+
+	t.Run("supports", func(t *testing.T) {
+		tr := transport.SSE{}
+
+		assert.True(t, tr.Supports(sseRequest("POST", "/graphql", "")))
+		assert.True(t, tr.Supports(sseRequest("GET", "/graphql", "")))
+
+		r := sseRequest("POST", "/graphql", "")
+		r.Header.Set("Accept", "application/json")
+		assert.False(t, tr.Supports(r), "requires text/event-stream")
+
+		r = sseRequest("POST", "/graphql", "")
+		r.Header.Set("Content-Type", "text/plain")
+		assert.False(t, tr.Supports(r), "requires a json body")
+
+		r = sseRequest("GET", "/graphql", "")
+		r.Header.Set("Upgrade", "websocket")
+		assert.False(t, tr.Supports(r), "websockets are not sse")
+	})
+
+	t.Run("query", func(t *testing.T) {
+		h := initialize()
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, sseRequest("POST", "/graphql", `{"query":"{ name }"}`))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "text/event-stream", w.Header().Get("Content-Type"))
+		assert.Equal(t, "no-cache", w.Header().Get("Cache-Control"))
+		assert.Equal(t, ":\n\nevent: next\ndata: {\"data\":{\"name\":\"test\"}}\n\n"+
+			"event: complete\ndata: \n\n", w.Body.String())
+	})
+
+	t.Run("get", func(t *testing.T) {
+		h := initialize()
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, sseRequest("GET", "/graphql?query={name}", ""))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, ":\n\nevent: next\ndata: {\"data\":{\"name\":\"test\"}}\n\n"+
+			"event: complete\ndata: \n\n", w.Body.String())
+	})
+
+	t.Run("no mutations over get", func(t *testing.T) {
+		h := initialize()
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, sseRequest("GET", "/graphql?query=mutation{name}", ""))
+
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+		assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+		assert.Equal(t, `{"errors":[{"message":"GET requests do not allow mutation operations"}],"data":null}`, w.Body.String())
+	})
+
+	t.Run("decode failure", func(t *testing.T) {
+		h := initialize()
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, sseRequest("POST", "/graphql", "notjson"))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+		assert.Equal(t, `{"errors":[{"message":"json body could not be decoded: invalid character 'o' in literal null (expecting 'u')"}],"data":null}`, w.Body.String())
+	})
+
+	t.Run("validation failure", func(t *testing.T) {
+		h := initialize()
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, sseRequest("POST", "/graphql", `{"query":"{ title }"}`))
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+		assert.Equal(t, `{"errors":[{"message":"Cannot query field \"title\" on type \"Query\".","locations":[{"line":1,"column":3}],"extensions":{"code":"GRAPHQL_VALIDATION_FAILED"}}],"data":null}`, w.Body.String())
+	})
+
+	t.Run("incremental delivery", func(t *testing.T) {
+		h := initialize()
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, sseRequest("POST", "/graphql", `{"query":"{ ... @defer { name } }"}`))
+
+		assert.Equal(t, ":\n\nevent: next\ndata: {\"data\":{},\"hasNext\":true}\n\n"+
+			"event: next\ndata: {\"incremental\":[{\"data\":{\"name\":\"test\"},\"path\":[]}],\"hasNext\":false}\n\n"+
+			"event: complete\ndata: \n\n", w.Body.String())
+	})
+
+	t.Run("subscription", func(t *testing.T) {
+		h := initialize()
+		srv := httptest.NewServer(h)
+		defer srv.Close()
+
+		r, err := http.NewRequest("POST", srv.URL, strings.NewReader(`{"query":"subscription { name }"}`))
+		require.NoError(t, err)
+		r.Header.Set("Content-Type", "application/json")
+		r.Header.Set("Accept", "text/event-stream")
+		resp, err := http.DefaultClient.Do(r)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+		events := bufio.NewReader(resp.Body)
+		requireEvent(t, events, ":\n\n")
+		for i := 0; i < 2; i++ {
+			h.SendNextSubscriptionMessage()
+			requireEvent(t, events, "event: next\ndata: {\"data\":{\"name\":\"test\"}}\n\n")
+		}
+		h.SendCompleteSubscriptionMessage()
+		requireEvent(t, events, "event: complete\ndata: \n\n")
+	})
+
+	t.Run("keep alive", func(t *testing.T) {
+		h := testserver.New()
+		h.AddTransport(transport.SSE{KeepAlivePingInterval: 10 * time.Millisecond})
+		srv := httptest.NewServer(h)
+		defer srv.Close()
+
+		r, err := http.NewRequest("GET", srv.URL+"?query=subscription{name}", nil)
+		require.NoError(t, err)
+		r.Header.Set("Accept", "text/event-stream")
+		resp, err := http.DefaultClient.Do(r)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		events := bufio.NewReader(resp.Body)
+		requireEvent(t, events, ":\n\n")
+		requireEvent(t, events, ":\n\n")
+		h.SendCompleteSubscriptionMessage()
+	})
+
+	t.Run("shutdown completes the subscription", func(t *testing.T) {
+		h := initialize()
+		srv := httptest.NewServer(h)
+		defer srv.Close()
+
+		r, err := http.NewRequest("GET", srv.URL+"?query=subscription{name}", nil)
+		require.NoError(t, err)
+		r.Header.Set("Accept", "text/event-stream")
+		resp, err := http.DefaultClient.Do(r)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		events := bufio.NewReader(resp.Body)
+		requireEvent(t, events, ":\n\n")
+		h.SendNextSubscriptionMessage()
+		requireEvent(t, events, "event: next\ndata: {\"data\":{\"name\":\"test\"}}\n\n")
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		require.NoError(t, h.Shutdown(ctx))
+		requireEvent(t, events, "event: complete\ndata: \n\n")
+	})
+
+	t.Run("client disconnect ends the subscription", func(t *testing.T) {
+		h := initialize()
+		done := make(chan struct{})
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer close(done)
+			h.ServeHTTP(w, r)
+		}))
+		defer srv.Close()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		r, err := http.NewRequestWithContext(ctx, "GET", srv.URL+"?query=subscription{name}", nil)
+		require.NoError(t, err)
+		r.Header.Set("Accept", "text/event-stream")
+		resp, err := http.DefaultClient.Do(r)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		cancel()
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("subscription was not cancelled")
+		}
+	})
+}
+
+// requireEvent reads the next event from an event stream and checks it matches expected
+func requireEvent(t *testing.T, events *bufio.Reader, expected string) {
+	var event strings.Builder
+	for !strings.HasSuffix(event.String(), "\n\n") {
+		line, err := events.ReadString('\n')
+		require.NoError(t, err)
+		event.WriteString(line)
+	}
+	require.Equal(t, expected, event.String())
+}
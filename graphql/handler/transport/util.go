@@ -1,11 +1,14 @@
 package transport
 
 import (
+	"bufio"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 
 	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
@@ -17,6 +20,56 @@ func writeJson(w io.Writer, response *graphql.Response) {
 	w.Write(b)
 }
 
+// writeResponse writes response like writeJson, except that streamed data is written to w as it is marshaled instead of
+// being buffered. The errors and extensions are written after the data.
+func writeResponse(ctx context.Context, w responseWriter, response *graphql.Response) {
+	if response == nil || response.StreamedData == nil {
+		writeJson(w, response)
+		return
+	}
+
+	// fasthttp buffers the body written by handlers, so the data is only streamed by a body stream writer, called once
+	// the handler returned. The data is resolved already, so marshaling it doesn't need the request.
+	if fw, ok := w.(fastHTTPResponseWriter); ok {
+		drainer := GetDrainer(ctx)
+		drainer.Hold()
+		fw.rctx.SetBodyStreamWriter(func(bw *bufio.Writer) {
+			defer drainer.Release()
+			writeStreamedResponse(bw, response)
+		})
+		return
+	}
+	writeStreamedResponse(w, response)
+}
+
+func writeStreamedResponse(w io.Writer, response *graphql.Response) {
+	bw := bufio.NewWriter(w)
+	bw.WriteString(`{"data":`)
+	response.StreamedData.MarshalGQL(bw)
+	if len(response.Errors) > 0 {
+		bw.WriteString(`,"errors":`)
+		writeJsonValue(bw, response.Errors)
+	}
+	if response.HasNext != nil {
+		bw.WriteString(`,"hasNext":`)
+		writeJsonValue(bw, *response.HasNext)
+	}
+	if len(response.Extensions) > 0 {
+		bw.WriteString(`,"extensions":`)
+		writeJsonValue(bw, response.Extensions)
+	}
+	bw.WriteString("}")
+	bw.Flush()
+}
+
+func writeJsonValue(w io.Writer, v interface{}) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	w.Write(b)
+}
+
 func writeJsonError(w io.Writer, msg string) {
 	writeJson(w, &graphql.Response{Errors: gqlerror.List{{Message: msg}}})
 }
@@ -28,3 +81,14 @@ func writeJsonErrorf(w io.Writer, format string, args ...interface{}) {
 func writeJsonGraphqlError(w io.Writer, err ...*gqlerror.Error) {
 	writeJson(w, &graphql.Response{Errors: err})
 }
+
+// setCacheControl sends the cache policy of a query answered without errors in a Cache-Control header
+func setCacheControl(ctx context.Context, w responseWriter, rc *graphql.OperationContext, response *graphql.Response) {
+	policy := graphql.GetCachePolicy(ctx)
+	if policy == nil || response == nil || len(response.Errors) > 0 || rc.Operation == nil || rc.Operation.Operation != ast.Query {
+		return
+	}
+	if header := policy.Header(); header != "" {
+		setHeader(w, "Cache-Control", header)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"log"
 	"net/http"
 	"sync"
@@ -13,42 +14,92 @@ import (
 
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/errcode"
+	fastws "github.com/fasthttp/websocket"
 	"github.com/gorilla/websocket"
+	"github.com/valyala/fasthttp"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 type (
 	Websocket struct {
 		Upgrader              websocket.Upgrader
+		FastHTTPUpgrader      fastws.FastHTTPUpgrader
 		InitFunc              WebsocketInitFunc
+		CloseFunc             WebsocketCloseFunc
+		ErrorFunc             WebsocketErrorFunc
+		OnOperationStart      WebsocketOperationFunc
+		OnOperationComplete   WebsocketOperationFunc
 		KeepAlivePingInterval time.Duration
 		PingPongInterval      time.Duration
 
+		// ConnectionInitWaitTimeout closes the connections whose client doesn't send connection_init in time, with
+		// the 4408 close code. 0 waits forever.
+		ConnectionInitWaitTimeout time.Duration
+
 		didInjectSubprotocols bool
 	}
 	wsConnection struct {
 		Websocket
 		ctx             context.Context
-		conn            *websocket.Conn
+		conn            wsConn
 		me              messageExchanger
 		active          map[string]context.CancelFunc
 		mu              sync.Mutex
 		keepAliveTicker *time.Ticker
 		pingPongTicker  *time.Ticker
 		exec            graphql.GraphExecutor
+		goingAway       bool
+		closed          bool
+
+		// graphqltransportws tells whether the connection uses the graphql-transport-ws subprotocol, which closes
+		// connections breaking the protocol with its own close codes
+		graphqltransportws bool
 
 		initPayload InitPayload
 	}
 
-	WebsocketInitFunc func(ctx context.Context, initPayload InitPayload) (context.Context, error)
+	// WebsocketInitFunc is called with the payload of the connection_init message. It returns the context of the
+	// operations of the connection, and the payload of the connection_ack message if any. Returning an error rejects
+	// the connection.
+	WebsocketInitFunc func(ctx context.Context, initPayload InitPayload) (context.Context, *InitPayload, error)
+
+	// WebsocketCloseFunc is called once the connection is closed, with the close code sent by the server or, when
+	// the client closed the connection, by the client.
+	WebsocketCloseFunc func(ctx context.Context, closeCode int)
+
+	// WebsocketErrorFunc is called with the errors of the connection: messages that can't be read or written, and
+	// messages breaking the protocol. The errors of operations are sent to the client instead.
+	WebsocketErrorFunc func(ctx context.Context, err error)
+
+	// WebsocketOperationFunc is called when an operation of the connection starts or completes, with the id given by
+	// the client. The operation context is in ctx.
+	WebsocketOperationFunc func(ctx context.Context, id string)
+
+	// wsConn is the part of a websocket connection used by the transport, it is implemented by both the
+	// gorilla/websocket and fasthttp/websocket connections.
+	wsConn interface {
+		NextReader() (messageType int, r io.Reader, err error)
+		WriteJSON(v interface{}) error
+		WriteMessage(messageType int, data []byte) error
+		SetReadDeadline(t time.Time) error
+		Subprotocol() string
+		Close() error
+	}
 )
 
-var _ graphql.Transport = Websocket{}
+var (
+	_ graphql.Transport = Websocket{}
+	_ FastTransport     = Websocket{}
+)
 
 func (t Websocket) Supports(r *http.Request) bool {
 	return r.Header.Get("Upgrade") != ""
 }
 
+func (t Websocket) SupportsFastHTTP(rctx *fasthttp.RequestCtx) bool {
+	return len(rctx.Request.Header.Peek("Upgrade")) != 0
+}
+
 func (t Websocket) Do(w http.ResponseWriter, r *http.Request, exec graphql.GraphExecutor) {
 	t.injectGraphQLWSSubprotocols()
 	ws, err := t.Upgrader.Upgrade(w, r, http.Header{})
@@ -58,7 +109,29 @@ func (t Websocket) Do(w http.ResponseWriter, r *http.Request, exec graphql.Graph
 		return
 	}
 
+	t.serve(r.Context(), ws, exec)
+}
+
+func (t Websocket) DoFastHTTP(ctx context.Context, rctx *fasthttp.RequestCtx, exec graphql.GraphExecutor) {
+	t.injectGraphQLWSSubprotocols()
+
+	// the connection outlives the fasthttp request, so it can't use a context derived from it
+	ctx = WithDrainer(detachFastHTTPContext(rctx), GetDrainer(ctx))
+	err := t.FastHTTPUpgrader.Upgrade(rctx, func(ws *fastws.Conn) {
+		t.serve(ctx, ws, exec)
+	})
+	if err != nil {
+		log.Printf("unable to upgrade %T to websocket %s: ", rctx, err.Error())
+		rctx.ResetBody()
+		rctx.SetContentType("application/json")
+		rctx.SetStatusCode(http.StatusBadRequest)
+		writeJsonError(rctx, "unable to upgrade")
+	}
+}
+
+func (t Websocket) serve(ctx context.Context, ws wsConn, exec graphql.GraphExecutor) {
 	var me messageExchanger
+	var graphqltransportws bool
 	switch ws.Subprotocol() {
 	default:
 		msg := websocket.FormatCloseMessage(websocket.CloseProtocolError, fmt.Sprintf("unsupported negotiated subprotocol %s", ws.Subprotocol()))
@@ -70,16 +143,29 @@ func (t Websocket) Do(w http.ResponseWriter, r *http.Request, exec graphql.Graph
 		me = graphqlwsMessageExchanger{c: ws}
 	case graphqltransportwsSubprotocol:
 		me = graphqltransportwsMessageExchanger{c: ws}
+		graphqltransportws = true
 	}
 
 	conn := wsConnection{
-		active:    map[string]context.CancelFunc{},
-		conn:      ws,
-		ctx:       r.Context(),
-		exec:      exec,
-		me:        me,
-		Websocket: t,
+		active:             map[string]context.CancelFunc{},
+		conn:               ws,
+		ctx:                ctx,
+		exec:               exec,
+		me:                 me,
+		graphqltransportws: graphqltransportws,
+		Websocket:          t,
+	}
+
+	drainer := GetDrainer(ctx)
+	if !drainer.Accept() {
+		conn.close(websocket.CloseGoingAway, "server shutting down")
+		return
 	}
+	defer drainer.Release()
+
+	done := make(chan struct{})
+	defer close(done)
+	go conn.goAwayOnShutdown(drainer, done)
 
 	if !conn.init() {
 		return
@@ -89,56 +175,148 @@ func (t Websocket) Do(w http.ResponseWriter, r *http.Request, exec graphql.Graph
 }
 
 func (c *wsConnection) init() bool {
-	m, err := c.me.NextMessage()
-	if err != nil {
-		if err == errInvalidMsg {
-			c.sendConnectionError("invalid json")
-		}
-
-		c.close(websocket.CloseProtocolError, "decoding error")
-		return false
+	var timer *time.Timer
+	if c.ConnectionInitWaitTimeout != 0 {
+		timer = time.AfterFunc(c.ConnectionInitWaitTimeout, func() {
+			c.close(graphqltransportwsInitTimeoutCloseCode, "Connection initialisation timeout")
+		})
+		defer timer.Stop()
 	}
 
-	switch m.t {
-	case initMessageType:
-		if len(m.payload) > 0 {
-			c.initPayload = make(InitPayload)
-			err := json.Unmarshal(m.payload, &c.initPayload)
-			if err != nil {
+	for {
+		m, err := c.me.NextMessage()
+		if err != nil {
+			if code, closed := closeCode(err); closed {
+				c.close(code, "")
 				return false
 			}
+			if c.isClosed() {
+				// the connection was closed by the server, once the init timeout expired for instance
+				return false
+			}
+
+			c.error(err)
+			if errors.Is(err, errInvalidMsg) {
+				c.sendConnectionError("invalid json")
+			}
+
+			c.closeInvalid("decoding error")
+			return false
 		}
 
-		if c.InitFunc != nil {
-			ctx, err := c.InitFunc(c.ctx, c.initPayload)
-			if err != nil {
-				c.sendConnectionError(err.Error())
-				c.close(websocket.CloseNormalClosure, "terminated")
+		switch m.t {
+		case initMessageType:
+			if timer != nil && !timer.Stop() {
+				// the init timeout expired while the message was being read
+				return false
+			}
+			return c.accept(m)
+		case connectionCloseMessageType:
+			c.close(websocket.CloseNormalClosure, "terminated")
+			return false
+		case pingMesageType:
+			c.write(&message{t: pongMessageType, payload: m.payload})
+		case pongMessageType:
+		case startMessageType:
+			if c.graphqltransportws {
+				c.close(graphqltransportwsUnauthorizedCloseCode, "Unauthorized")
 				return false
 			}
-			c.ctx = ctx
+			fallthrough
+		default:
+			c.error(fmt.Errorf("unexpected message %s", m.t))
+			c.sendConnectionError("unexpected message %s", m.t)
+			c.closeInvalid("unexpected message")
+			return false
 		}
+	}
+}
 
-		c.write(&message{t: connectionAckMessageType})
-		c.write(&message{t: keepAliveMessageType})
-	case connectionCloseMessageType:
-		c.close(websocket.CloseNormalClosure, "terminated")
-		return false
-	default:
-		c.sendConnectionError("unexpected message %s", m.t)
-		c.close(websocket.CloseProtocolError, "unexpected message")
-		return false
+// accept handles the connection_init message m, and acknowledges it unless the InitFunc rejects the connection.
+func (c *wsConnection) accept(m message) bool {
+	if len(m.payload) > 0 {
+		c.initPayload = make(InitPayload)
+		err := json.Unmarshal(m.payload, &c.initPayload)
+		if err != nil {
+			c.error(err)
+			c.closeInvalid("decoding error")
+			return false
+		}
+	}
+
+	var ackPayload *InitPayload
+	if c.InitFunc != nil {
+		ctx, payload, err := c.InitFunc(c.ctx, c.initPayload)
+		if err != nil {
+			c.sendConnectionError(err.Error())
+			if c.graphqltransportws {
+				c.close(graphqltransportwsForbiddenCloseCode, "Forbidden")
+			} else {
+				c.close(websocket.CloseNormalClosure, "terminated")
+			}
+			return false
+		}
+		c.mu.Lock()
+		c.ctx = ctx
+		c.mu.Unlock()
+		ackPayload = payload
 	}
 
+	ack := &message{t: connectionAckMessageType}
+	if ackPayload != nil {
+		b, err := json.Marshal(*ackPayload)
+		if err != nil {
+			panic(err)
+		}
+		ack.payload = b
+	}
+	c.write(ack)
+	c.write(&message{t: keepAliveMessageType})
 	return true
 }
 
+// closeInvalid closes the connection because the client broke the protocol.
+func (c *wsConnection) closeInvalid(reason string) {
+	if c.graphqltransportws {
+		c.close(graphqltransportwsInvalidMessageCloseCode, "Invalid message received")
+		return
+	}
+	c.close(websocket.CloseProtocolError, reason)
+}
+
+func (c *wsConnection) isClosed() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.closed
+}
+
 func (c *wsConnection) write(msg *message) {
 	c.mu.Lock()
-	// TODO: missing error handling here, err from previous implementation
-	// was ignored
-	_ = c.me.Send(msg)
+	// the operations have been completed already when the server is going away
+	if c.closed || c.goingAway {
+		c.mu.Unlock()
+		return
+	}
+	err := c.me.Send(msg)
 	c.mu.Unlock()
+
+	if err != nil {
+		c.error(fmt.Errorf("unable to send %s message: %w", msg.t, err))
+	}
+}
+
+// error reports err to the ErrorFunc, if any.
+func (c *wsConnection) error(err error) {
+	if c.ErrorFunc != nil {
+		c.ErrorFunc(c.getContext(), err)
+	}
+}
+
+// getContext returns the context of the connection, which the InitFunc may replace while other goroutines use it.
+func (c *wsConnection) getContext() context.Context {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.ctx
 }
 
 func (c *wsConnection) run() {
@@ -178,7 +356,14 @@ func (c *wsConnection) run() {
 		start := graphql.Now()
 		m, err := c.me.NextMessage()
 		if err != nil {
-			// TODO: better error handling here
+			if code, closed := closeCode(err); closed {
+				c.close(code, "")
+			} else if !c.isClosed() {
+				c.error(err)
+				if c.graphqltransportws && errors.Is(err, errInvalidMsg) {
+					c.closeInvalid("invalid message")
+				}
+			}
 			return
 		}
 
@@ -199,9 +384,16 @@ func (c *wsConnection) run() {
 			c.write(&message{t: pongMessageType, payload: m.payload})
 		case pongMessageType:
 			c.conn.SetReadDeadline(time.Now().UTC().Add(2 * c.PingPongInterval))
+		case initMessageType:
+			if c.graphqltransportws {
+				c.close(graphqltransportwsTooManyInitCloseCode, "Too many initialisation requests")
+				return
+			}
+			fallthrough
 		default:
+			c.error(fmt.Errorf("unexpected message %s", m.t))
 			c.sendConnectionError("unexpected message %s", m.t)
-			c.close(websocket.CloseProtocolError, "unexpected message")
+			c.closeInvalid("unexpected message")
 			return
 		}
 	}
@@ -240,7 +432,38 @@ func (c *wsConnection) closeOnCancel(ctx context.Context) {
 	c.close(websocket.CloseNormalClosure, "terminated")
 }
 
+// goAwayOnShutdown completes the active operations and closes the connection once the server starts shutting down,
+// unless done is closed first.
+func (c *wsConnection) goAwayOnShutdown(drainer *Drainer, done <-chan struct{}) {
+	select {
+	case <-done:
+		return
+	case <-drainer.ShuttingDown():
+	}
+
+	c.mu.Lock()
+	for id, closer := range c.active {
+		closer()
+		_ = c.me.Send(&message{id: id, t: completeMessageType})
+		delete(c.active, id)
+	}
+	c.goingAway = true
+	c.mu.Unlock()
+
+	c.close(websocket.CloseGoingAway, "server shutting down")
+}
+
 func (c *wsConnection) subscribe(start time.Time, msg *message) {
+	if c.graphqltransportws {
+		c.mu.Lock()
+		_, exists := c.active[msg.id]
+		c.mu.Unlock()
+		if exists {
+			c.close(graphqltransportwsSubscriberExistsCloseCode, fmt.Sprintf("Subscriber for %s already exists", msg.id))
+			return
+		}
+	}
+
 	ctx := graphql.StartOperationTrace(c.ctx)
 	var params *graphql.RawParams
 	if err := jsonDecode(bytes.NewReader(msg.payload), &params); err != nil {
@@ -268,6 +491,7 @@ func (c *wsConnection) subscribe(start time.Time, msg *message) {
 		return
 	}
 
+	rc.IncrementalDelivery = true
 	ctx = graphql.WithOperationContext(ctx, rc)
 
 	if c.initPayload != nil {
@@ -279,6 +503,10 @@ func (c *wsConnection) subscribe(start time.Time, msg *message) {
 	c.active[msg.id] = cancel
 	c.mu.Unlock()
 
+	if c.OnOperationStart != nil {
+		c.OnOperationStart(ctx, msg.id)
+	}
+
 	go func() {
 		defer func() {
 			if r := recover(); r != nil {
@@ -292,11 +520,16 @@ func (c *wsConnection) subscribe(start time.Time, msg *message) {
 				}
 				c.sendError(msg.id, gqlerr)
 			}
-			c.complete(msg.id)
+			// the id can be reused once the client is told the operation completed
 			c.mu.Lock()
 			delete(c.active, msg.id)
 			c.mu.Unlock()
+			c.complete(msg.id)
 			cancel()
+
+			if c.OnOperationComplete != nil {
+				c.OnOperationComplete(ctx, msg.id)
+			}
 		}()
 
 		responses, ctx := c.exec.DispatchOperation(ctx, rc)
@@ -308,12 +541,6 @@ func (c *wsConnection) subscribe(start time.Time, msg *message) {
 
 			c.sendResponse(msg.id, response)
 		}
-		c.complete(msg.id)
-
-		c.mu.Lock()
-		delete(c.active, msg.id)
-		c.mu.Unlock()
-		cancel()
 	}()
 }
 
@@ -356,10 +583,20 @@ func (c *wsConnection) sendConnectionError(format string, args ...interface{}) {
 
 func (c *wsConnection) close(closeCode int, message string) {
 	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		return
+	}
+	c.closed = true
 	_ = c.conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(closeCode, message))
 	for _, closer := range c.active {
 		closer()
 	}
+	ctx := c.ctx
 	c.mu.Unlock()
 	_ = c.conn.Close()
+
+	if c.CloseFunc != nil {
+		c.CloseFunc(ctx, closeCode)
+	}
 }
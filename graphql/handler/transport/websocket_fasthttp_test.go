@@ -0,0 +1,253 @@
+package transport_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql/handler/testserver"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valyala/fasthttp"
+)
+
+func TestWebsocketFastHTTP(t *testing.T) {
+	handler := testserver.New()
+	handler.AddTransport(transport.Websocket{})
+
+	url, closeServer := serveFastHTTP(t, handler)
+	defer closeServer()
+
+	t.Run("client must send valid json", func(t *testing.T) {
+		c := wsConnect(url)
+		defer c.Close()
+
+		writeRaw(c, "hello")
+
+		msg := readOp(c)
+		assert.Equal(t, "connection_error", msg.Type)
+		assert.Equal(t, `{"message":"invalid json"}`, string(msg.Payload))
+	})
+
+	t.Run("client can terminate before init", func(t *testing.T) {
+		c := wsConnect(url)
+		defer c.Close()
+
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: connectionTerminateMsg}))
+
+		_, _, err := c.ReadMessage()
+		assert.Equal(t, websocket.CloseNormalClosure, err.(*websocket.CloseError).Code)
+	})
+
+	t.Run("client must send init first", func(t *testing.T) {
+		c := wsConnect(url)
+		defer c.Close()
+
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: startMsg}))
+
+		msg := readOp(c)
+		assert.Equal(t, connectionErrorMsg, msg.Type)
+		assert.Equal(t, `{"message":"unexpected message start"}`, string(msg.Payload))
+	})
+
+	t.Run("server acks init", func(t *testing.T) {
+		c := wsConnect(url)
+		defer c.Close()
+
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: connectionInitMsg}))
+
+		assert.Equal(t, connectionAckMsg, readOp(c).Type)
+		assert.Equal(t, connectionKeepAliveMsg, readOp(c).Type)
+	})
+
+	t.Run("client gets parse errors", func(t *testing.T) {
+		c := wsConnect(url)
+		defer c.Close()
+
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: connectionInitMsg}))
+		assert.Equal(t, connectionAckMsg, readOp(c).Type)
+		assert.Equal(t, connectionKeepAliveMsg, readOp(c).Type)
+
+		require.NoError(t, c.WriteJSON(&operationMessage{
+			Type:    startMsg,
+			ID:      "test_1",
+			Payload: json.RawMessage(`{"query": "!"}`),
+		}))
+
+		msg := readOp(c)
+		assert.Equal(t, errorMsg, msg.Type)
+		assert.Equal(t, `[{"message":"Unexpected !","locations":[{"line":1,"column":1}],"extensions":{"code":"GRAPHQL_PARSE_FAILED"}}]`, string(msg.Payload))
+	})
+
+	t.Run("client can receive data", func(t *testing.T) {
+		c := wsConnect(url)
+		defer c.Close()
+
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: connectionInitMsg}))
+		assert.Equal(t, connectionAckMsg, readOp(c).Type)
+		assert.Equal(t, connectionKeepAliveMsg, readOp(c).Type)
+
+		require.NoError(t, c.WriteJSON(&operationMessage{
+			Type:    startMsg,
+			ID:      "test_1",
+			Payload: json.RawMessage(`{"query": "subscription { name }"}`),
+		}))
+
+		handler.SendNextSubscriptionMessage()
+		msg := readOp(c)
+		require.Equal(t, dataMsg, msg.Type, string(msg.Payload))
+		require.Equal(t, "test_1", msg.ID, string(msg.Payload))
+		require.Equal(t, `{"data":{"name":"test"}}`, string(msg.Payload))
+
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: stopMsg, ID: "test_1"}))
+
+		msg = readOp(c)
+		require.Equal(t, completeMsg, msg.Type)
+		require.Equal(t, "test_1", msg.ID)
+	})
+}
+
+func TestWebsocketFastHTTPInitFunc(t *testing.T) {
+	t.Run("reject connection if WebsocketInitFunc is provided and is accepting connection", func(t *testing.T) {
+		h := testserver.New()
+		h.AddTransport(transport.Websocket{
+			InitFunc: func(ctx context.Context, initPayload transport.InitPayload) (context.Context, *transport.InitPayload, error) {
+				return ctx, nil, errors.New("invalid init payload")
+			},
+		})
+		url, closeServer := serveFastHTTP(t, h)
+		defer closeServer()
+
+		c := wsConnect(url)
+		defer c.Close()
+
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: connectionInitMsg}))
+
+		msg := readOp(c)
+		assert.Equal(t, connectionErrorMsg, msg.Type)
+		assert.Equal(t, `{"message":"invalid init payload"}`, string(msg.Payload))
+	})
+
+	t.Run("can read fasthttp user values from the connection context", func(t *testing.T) {
+		h := testserver.New()
+		h.AddTransport(transport.Websocket{
+			InitFunc: func(ctx context.Context, initPayload transport.InitPayload) (context.Context, *transport.InitPayload, error) {
+				if ctx.Value("user") != "bob" {
+					return ctx, nil, errors.New("missing user")
+				}
+				return ctx, nil, nil
+			},
+		})
+		url, closeServer := serveFastHTTP(t, fastHTTPHandlerFunc(func(rctx *fasthttp.RequestCtx) {
+			rctx.SetUserValue("user", "bob")
+			h.ServeFastHTTP(rctx)
+		}))
+		defer closeServer()
+
+		c := wsConnect(url)
+		defer c.Close()
+
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: connectionInitMsg}))
+		assert.Equal(t, connectionAckMsg, readOp(c).Type)
+	})
+}
+
+func TestWebsocketFastHTTPGraphqltransportwsSubprotocol(t *testing.T) {
+	handler := testserver.New()
+	handler.AddTransport(transport.Websocket{
+		PingPongInterval: time.Second * 1,
+	})
+
+	url, closeServer := serveFastHTTP(t, handler)
+	defer closeServer()
+
+	t.Run("client can receive data", func(t *testing.T) {
+		c := wsConnectWithSubprocotol(url, graphqltransportwsSubprotocol)
+		defer c.Close()
+
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: graphqltransportwsConnectionInitMsg}))
+		assert.Equal(t, graphqltransportwsConnectionAckMsg, readOp(c).Type)
+
+		require.NoError(t, c.WriteJSON(&operationMessage{
+			Type:    graphqltransportwsSubscribeMsg,
+			ID:      "test_1",
+			Payload: json.RawMessage(`{"query": "subscription { name }"}`),
+		}))
+
+		handler.SendNextSubscriptionMessage()
+		msg := readOp(c)
+		require.Equal(t, graphqltransportwsNextMsg, msg.Type, string(msg.Payload))
+		require.Equal(t, "test_1", msg.ID, string(msg.Payload))
+		require.Equal(t, `{"data":{"name":"test"}}`, string(msg.Payload))
+
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: graphqltransportwsCompleteMsg, ID: "test_1"}))
+
+		msg = readOp(c)
+		require.Equal(t, graphqltransportwsCompleteMsg, msg.Type)
+		require.Equal(t, "test_1", msg.ID)
+	})
+
+	t.Run("client sends ping and expects pong", func(t *testing.T) {
+		c := wsConnectWithSubprocotol(url, graphqltransportwsSubprotocol)
+		defer c.Close()
+
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: graphqltransportwsConnectionInitMsg}))
+		assert.Equal(t, graphqltransportwsConnectionAckMsg, readOp(c).Type)
+
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: graphqltransportwsPingMsg}))
+		assert.Equal(t, graphqltransportwsPongMsg, readOp(c).Type)
+	})
+
+	t.Run("client receives ping and responds with pong", func(t *testing.T) {
+		c := wsConnectWithSubprocotol(url, graphqltransportwsSubprotocol)
+		defer c.Close()
+
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: graphqltransportwsConnectionInitMsg}))
+		assert.Equal(t, graphqltransportwsConnectionAckMsg, readOp(c).Type)
+
+		assert.Equal(t, graphqltransportwsPingMsg, readOp(c).Type)
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: graphqltransportwsPongMsg}))
+		assert.Equal(t, graphqltransportwsPingMsg, readOp(c).Type)
+	})
+}
+
+func TestWebsocketFastHTTPShutdown(t *testing.T) {
+	handler := testserver.New()
+	handler.AddTransport(transport.Websocket{})
+
+	url, closeServer := serveFastHTTP(t, handler)
+	defer closeServer()
+
+	c := wsConnectWithSubprocotol(url, graphqltransportwsSubprotocol)
+	defer c.Close()
+
+	require.NoError(t, c.WriteJSON(&operationMessage{Type: graphqltransportwsConnectionInitMsg}))
+	assert.Equal(t, graphqltransportwsConnectionAckMsg, readOp(c).Type)
+
+	require.NoError(t, c.WriteJSON(&operationMessage{
+		Type:    graphqltransportwsSubscribeMsg,
+		ID:      "test_1",
+		Payload: json.RawMessage(`{"query": "subscription { name }"}`),
+	}))
+	handler.SendNextSubscriptionMessage()
+	require.Equal(t, graphqltransportwsNextMsg, readOp(c).Type)
+
+	shutdown := make(chan error)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		shutdown <- handler.Shutdown(ctx)
+	}()
+
+	msg := readOp(c)
+	require.Equal(t, graphqltransportwsCompleteMsg, msg.Type)
+	require.Equal(t, "test_1", msg.ID)
+
+	_, _, err := c.ReadMessage()
+	assert.Equal(t, websocket.CloseGoingAway, err.(*websocket.CloseError).Code)
+	require.NoError(t, <-shutdown)
+}
@@ -3,8 +3,6 @@ package transport
 import (
 	"encoding/json"
 	"fmt"
-
-	"github.com/gorilla/websocket"
 )
 
 // https://github.com/enisdenjo/graphql-ws/blob/master/PROTOCOL.md
@@ -21,6 +19,16 @@ const (
 	graphqltransportwsPongMsg           = graphqltransportwsMessageType("pong")
 )
 
+// the close codes of the connections breaking the protocol
+const (
+	graphqltransportwsInvalidMessageCloseCode   = 4400
+	graphqltransportwsUnauthorizedCloseCode     = 4401
+	graphqltransportwsForbiddenCloseCode        = 4403
+	graphqltransportwsInitTimeoutCloseCode      = 4408
+	graphqltransportwsSubscriberExistsCloseCode = 4409
+	graphqltransportwsTooManyInitCloseCode      = 4429
+)
+
 var allGraphqltransportwsMessageTypes = []graphqltransportwsMessageType{
 	graphqltransportwsConnectionInitMsg,
 	graphqltransportwsConnectionAckMsg,
@@ -34,7 +42,7 @@ var allGraphqltransportwsMessageTypes = []graphqltransportwsMessageType{
 
 type (
 	graphqltransportwsMessageExchanger struct {
-		c *websocket.Conn
+		c wsConn
 	}
 
 	graphqltransportwsMessage struct {
@@ -58,7 +66,11 @@ func (me graphqltransportwsMessageExchanger) NextMessage() (message, error) {
 		return message{}, errInvalidMsg
 	}
 
-	return graphqltransportwsMessage.toMessage()
+	m, err := graphqltransportwsMessage.toMessage()
+	if err != nil {
+		return message{}, fmt.Errorf("%w: %s", errInvalidMsg, err)
+	}
+	return m, nil
 }
 
 func (me graphqltransportwsMessageExchanger) Send(m *message) error {
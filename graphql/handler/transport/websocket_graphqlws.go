@@ -3,8 +3,6 @@ package transport
 import (
 	"encoding/json"
 	"fmt"
-
-	"github.com/gorilla/websocket"
 )
 
 // https://github.com/apollographql/subscriptions-transport-ws/blob/master/PROTOCOL.md
@@ -38,7 +36,7 @@ var allGraphqlwsMessageTypes = []graphqlwsMessageType{
 
 type (
 	graphqlwsMessageExchanger struct {
-		c *websocket.Conn
+		c wsConn
 	}
 
 	graphqlwsMessage struct {
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 
+	fastws "github.com/fasthttp/websocket"
 	"github.com/gorilla/websocket"
 )
 
@@ -101,6 +102,9 @@ func (t *Websocket) injectGraphQLWSSubprotocols() {
 			if !contains(t.Upgrader.Subprotocols, subprotocol) {
 				t.Upgrader.Subprotocols = append(t.Upgrader.Subprotocols, subprotocol)
 			}
+			if !contains(t.FastHTTPUpgrader.Subprotocols, subprotocol) {
+				t.FastHTTPUpgrader.Subprotocols = append(t.FastHTTPUpgrader.Subprotocols, subprotocol)
+			}
 		}
 	}
 }
@@ -108,9 +112,28 @@ func (t *Websocket) injectGraphQLWSSubprotocols() {
 func handleNextReaderError(err error) error {
 	// TODO: should we consider all closure scenarios here for the ws connection?
 	// for now we only list the error codes from the previous implementation
-	if websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseNoStatusReceived) {
+	if websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseNoStatusReceived) ||
+		fastws.IsCloseError(err, fastws.CloseNormalClosure, fastws.CloseNoStatusReceived) {
 		return errWsConnClosed
 	}
 
 	return err
 }
+
+// closeCode returns the close code sent by the client, when err is the error of reading from a connection it closed.
+func closeCode(err error) (int, bool) {
+	if err == errWsConnClosed {
+		return websocket.CloseNormalClosure, true
+	}
+
+	var closeErr *websocket.CloseError
+	if errors.As(err, &closeErr) {
+		return closeErr.Code, true
+	}
+	var fastCloseErr *fastws.CloseError
+	if errors.As(err, &fastCloseErr) {
+		return fastCloseErr.Code, true
+	}
+
+	return 0, false
+}
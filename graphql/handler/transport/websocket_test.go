@@ -195,8 +195,8 @@ func TestWebsocketInitFunc(t *testing.T) {
 	t.Run("accept connection if WebsocketInitFunc is provided and is accepting connection", func(t *testing.T) {
 		h := testserver.New()
 		h.AddTransport(transport.Websocket{
-			InitFunc: func(ctx context.Context, initPayload transport.InitPayload) (context.Context, error) {
-				return context.WithValue(ctx, ckey("newkey"), "newvalue"), nil
+			InitFunc: func(ctx context.Context, initPayload transport.InitPayload) (context.Context, *transport.InitPayload, error) {
+				return context.WithValue(ctx, ckey("newkey"), "newvalue"), nil, nil
 			},
 		})
 		srv := httptest.NewServer(h)
@@ -214,8 +214,8 @@ func TestWebsocketInitFunc(t *testing.T) {
 	t.Run("reject connection if WebsocketInitFunc is provided and is accepting connection", func(t *testing.T) {
 		h := testserver.New()
 		h.AddTransport(transport.Websocket{
-			InitFunc: func(ctx context.Context, initPayload transport.InitPayload) (context.Context, error) {
-				return ctx, errors.New("invalid init payload")
+			InitFunc: func(ctx context.Context, initPayload transport.InitPayload) (context.Context, *transport.InitPayload, error) {
+				return ctx, nil, errors.New("invalid init payload")
 			},
 		})
 		srv := httptest.NewServer(h)
@@ -249,8 +249,8 @@ func TestWebsocketInitFunc(t *testing.T) {
 		h := handler.New(es)
 
 		h.AddTransport(transport.Websocket{
-			InitFunc: func(ctx context.Context, initPayload transport.InitPayload) (context.Context, error) {
-				return context.WithValue(ctx, ckey("newkey"), "newvalue"), nil
+			InitFunc: func(ctx context.Context, initPayload transport.InitPayload) (context.Context, *transport.InitPayload, error) {
+				return context.WithValue(ctx, ckey("newkey"), "newvalue"), nil, nil
 			},
 		})
 
@@ -270,7 +270,7 @@ func TestWebsocketInitFunc(t *testing.T) {
 		h := testserver.New()
 		var cancel func()
 		h.AddTransport(transport.Websocket{
-			InitFunc: func(ctx context.Context, _ transport.InitPayload) (newCtx context.Context, _ error) {
+			InitFunc: func(ctx context.Context, _ transport.InitPayload) (newCtx context.Context, _ *transport.InitPayload, _ error) {
 				newCtx, cancel = context.WithTimeout(transport.AppendCloseReason(ctx, "beep boop"), time.Millisecond*5)
 				return
 			},
@@ -293,6 +293,116 @@ func TestWebsocketInitFunc(t *testing.T) {
 	})
 }
 
+func TestWebsocketHooks(t *testing.T) {
+	t.Run("init func can send an ack payload", func(t *testing.T) {
+		h := testserver.New()
+		h.AddTransport(transport.Websocket{
+			InitFunc: func(ctx context.Context, initPayload transport.InitPayload) (context.Context, *transport.InitPayload, error) {
+				return ctx, &transport.InitPayload{"version": "1"}, nil
+			},
+		})
+		srv := httptest.NewServer(h)
+		defer srv.Close()
+
+		c := wsConnect(srv.URL)
+		defer c.Close()
+
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: connectionInitMsg}))
+
+		msg := readOp(c)
+		assert.Equal(t, connectionAckMsg, msg.Type)
+		assert.Equal(t, `{"version":"1"}`, string(msg.Payload))
+	})
+
+	t.Run("operations call the start and complete hooks", func(t *testing.T) {
+		started := make(chan string, 1)
+		completed := make(chan string, 1)
+		h := testserver.New()
+		h.AddTransport(transport.Websocket{
+			OnOperationStart: func(ctx context.Context, id string) {
+				started <- id + " " + graphql.GetOperationContext(ctx).RawQuery
+			},
+			OnOperationComplete: func(ctx context.Context, id string) {
+				completed <- id
+			},
+		})
+		srv := httptest.NewServer(h)
+		defer srv.Close()
+
+		c := wsConnect(srv.URL)
+		defer c.Close()
+
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: connectionInitMsg}))
+		assert.Equal(t, connectionAckMsg, readOp(c).Type)
+		assert.Equal(t, connectionKeepAliveMsg, readOp(c).Type)
+
+		require.NoError(t, c.WriteJSON(&operationMessage{
+			Type:    startMsg,
+			ID:      "test_1",
+			Payload: json.RawMessage(`{"query": "subscription { name }"}`),
+		}))
+		assert.Equal(t, "test_1 subscription { name }", <-started)
+
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: stopMsg, ID: "test_1"}))
+		msg := readOp(c)
+		require.Equal(t, completeMsg, msg.Type)
+		assert.Equal(t, "test_1", <-completed)
+	})
+
+	t.Run("close func gets the close code", func(t *testing.T) {
+		closed := make(chan int, 1)
+		h := testserver.New()
+		h.AddTransport(transport.Websocket{
+			InitFunc: func(ctx context.Context, initPayload transport.InitPayload) (context.Context, *transport.InitPayload, error) {
+				return context.WithValue(ctx, ckey("newkey"), "newvalue"), nil, nil
+			},
+			CloseFunc: func(ctx context.Context, closeCode int) {
+				assert.Equal(t, "newvalue", ctx.Value(ckey("newkey")))
+				closed <- closeCode
+			},
+		})
+		srv := httptest.NewServer(h)
+		defer srv.Close()
+
+		c := wsConnect(srv.URL)
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: connectionInitMsg}))
+		assert.Equal(t, connectionAckMsg, readOp(c).Type)
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: connectionTerminateMsg}))
+		assert.Equal(t, websocket.CloseNormalClosure, <-closed)
+		c.Close()
+
+		c = wsConnect(srv.URL)
+		defer c.Close()
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: connectionInitMsg}))
+		assert.Equal(t, connectionAckMsg, readOp(c).Type)
+		require.NoError(t, c.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseGoingAway, "bye")))
+		assert.Equal(t, websocket.CloseGoingAway, <-closed)
+	})
+
+	t.Run("error func gets the protocol errors", func(t *testing.T) {
+		errs := make(chan error, 1)
+		h := testserver.New()
+		h.AddTransport(transport.Websocket{
+			ErrorFunc: func(ctx context.Context, err error) {
+				errs <- err
+			},
+		})
+		srv := httptest.NewServer(h)
+		defer srv.Close()
+
+		c := wsConnect(srv.URL)
+		defer c.Close()
+
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: connectionInitMsg}))
+		assert.Equal(t, connectionAckMsg, readOp(c).Type)
+		assert.Equal(t, connectionKeepAliveMsg, readOp(c).Type)
+
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: connectionInitMsg}))
+		assert.EqualError(t, <-errs, "unexpected message init")
+		assert.Equal(t, connectionErrorMsg, readOp(c).Type)
+	})
+}
+
 func TestWebsocketGraphqltransportwsSubprotocol(t *testing.T) {
 	handler := testserver.New()
 	handler.AddTransport(transport.Websocket{})
@@ -340,6 +450,170 @@ func TestWebsocketGraphqltransportwsSubprotocol(t *testing.T) {
 		require.Equal(t, graphqltransportwsCompleteMsg, msg.Type)
 		require.Equal(t, "test_1", msg.ID)
 	})
+
+	t.Run("client receives deferred payloads as next messages", func(t *testing.T) {
+		c := wsConnectWithSubprocotol(srv.URL, graphqltransportwsSubprotocol)
+		defer c.Close()
+
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: graphqltransportwsConnectionInitMsg}))
+		assert.Equal(t, graphqltransportwsConnectionAckMsg, readOp(c).Type)
+
+		require.NoError(t, c.WriteJSON(&operationMessage{
+			Type:    graphqltransportwsSubscribeMsg,
+			ID:      "test_1",
+			Payload: json.RawMessage(`{"query": "{ ... @defer(label: \"name\") { name } }"}`),
+		}))
+
+		msg := readOp(c)
+		require.Equal(t, graphqltransportwsNextMsg, msg.Type, string(msg.Payload))
+		require.Equal(t, "test_1", msg.ID, string(msg.Payload))
+		require.Equal(t, `{"data":{},"hasNext":true}`, string(msg.Payload))
+
+		msg = readOp(c)
+		require.Equal(t, graphqltransportwsNextMsg, msg.Type, string(msg.Payload))
+		require.Equal(t, "test_1", msg.ID, string(msg.Payload))
+		require.Equal(t, `{"incremental":[{"data":{"name":"test"},"path":[],"label":"name"}],"hasNext":false}`, string(msg.Payload))
+
+		msg = readOp(c)
+		require.Equal(t, graphqltransportwsCompleteMsg, msg.Type)
+		require.Equal(t, "test_1", msg.ID)
+	})
+}
+
+// TestWebsocketGraphqltransportwsConformance checks the connections breaking the graphql-transport-ws protocol are
+// closed with the close codes of https://github.com/enisdenjo/graphql-ws/blob/master/PROTOCOL.md
+func TestWebsocketGraphqltransportwsConformance(t *testing.T) {
+	handler := testserver.New()
+	handler.AddTransport(transport.Websocket{
+		ConnectionInitWaitTimeout: 50 * time.Millisecond,
+		InitFunc: func(ctx context.Context, initPayload transport.InitPayload) (context.Context, *transport.InitPayload, error) {
+			if initPayload.GetString("token") == "invalid" {
+				return ctx, nil, errors.New("invalid token")
+			}
+			return ctx, nil, nil
+		},
+	})
+
+	srv := httptest.NewServer(handler)
+	defer srv.Close()
+
+	connect := func(t *testing.T) *websocket.Conn {
+		c := wsConnectWithSubprocotol(srv.URL, graphqltransportwsSubprotocol)
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: graphqltransportwsConnectionInitMsg}))
+		assert.Equal(t, graphqltransportwsConnectionAckMsg, readOp(c).Type)
+		return c
+	}
+
+	t.Run("connection init must be sent in time", func(t *testing.T) {
+		c := wsConnectWithSubprocotol(srv.URL, graphqltransportwsSubprotocol)
+		defer c.Close()
+
+		requireClose(t, c, 4408, "Connection initialisation timeout")
+	})
+
+	t.Run("connection init is not timed out once received", func(t *testing.T) {
+		c := connect(t)
+		defer c.Close()
+
+		time.Sleep(100 * time.Millisecond)
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: graphqltransportwsPingMsg}))
+		assert.Equal(t, graphqltransportwsPongMsg, readOp(c).Type)
+	})
+
+	t.Run("connection init can only be sent once", func(t *testing.T) {
+		c := connect(t)
+		defer c.Close()
+
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: graphqltransportwsConnectionInitMsg}))
+		requireClose(t, c, 4429, "Too many initialisation requests")
+	})
+
+	t.Run("rejected connections are forbidden", func(t *testing.T) {
+		c := wsConnectWithSubprocotol(srv.URL, graphqltransportwsSubprotocol)
+		defer c.Close()
+
+		require.NoError(t, c.WriteJSON(&operationMessage{
+			Type:    graphqltransportwsConnectionInitMsg,
+			Payload: json.RawMessage(`{"token":"invalid"}`),
+		}))
+		requireClose(t, c, 4403, "Forbidden")
+	})
+
+	t.Run("subscribe must wait for the ack", func(t *testing.T) {
+		c := wsConnectWithSubprocotol(srv.URL, graphqltransportwsSubprotocol)
+		defer c.Close()
+
+		require.NoError(t, c.WriteJSON(&operationMessage{
+			Type:    graphqltransportwsSubscribeMsg,
+			ID:      "test_1",
+			Payload: json.RawMessage(`{"query": "subscription { name }"}`),
+		}))
+		requireClose(t, c, 4401, "Unauthorized")
+	})
+
+	t.Run("subscription ids must be unique", func(t *testing.T) {
+		c := connect(t)
+		defer c.Close()
+
+		for i := 0; i < 2; i++ {
+			require.NoError(t, c.WriteJSON(&operationMessage{
+				Type:    graphqltransportwsSubscribeMsg,
+				ID:      "test_1",
+				Payload: json.RawMessage(`{"query": "subscription { name }"}`),
+			}))
+		}
+		requireClose(t, c, 4409, "Subscriber for test_1 already exists")
+	})
+
+	t.Run("subscription ids can be reused once completed", func(t *testing.T) {
+		c := connect(t)
+		defer c.Close()
+
+		for i := 0; i < 2; i++ {
+			require.NoError(t, c.WriteJSON(&operationMessage{
+				Type:    graphqltransportwsSubscribeMsg,
+				ID:      "test_1",
+				Payload: json.RawMessage(`{"query": "{ name }"}`),
+			}))
+
+			msg := readOp(c)
+			require.Equal(t, graphqltransportwsNextMsg, msg.Type, string(msg.Payload))
+			msg = readOp(c)
+			require.Equal(t, graphqltransportwsCompleteMsg, msg.Type)
+			require.Equal(t, "test_1", msg.ID)
+		}
+	})
+
+	t.Run("invalid messages close the connection", func(t *testing.T) {
+		c := connect(t)
+		defer c.Close()
+
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: graphqltransportwsNextMsg, ID: "test_1"}))
+		requireClose(t, c, 4400, "Invalid message received")
+	})
+
+	t.Run("pong echoes the payload of ping", func(t *testing.T) {
+		c := connect(t)
+		defer c.Close()
+
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: graphqltransportwsPingMsg, Payload: json.RawMessage(`{"sent":42}`)}))
+		msg := readOp(c)
+		assert.Equal(t, graphqltransportwsPongMsg, msg.Type)
+		assert.Equal(t, `{"sent":42}`, string(msg.Payload))
+	})
+
+	t.Run("ping can be sent before the connection init", func(t *testing.T) {
+		c := wsConnectWithSubprocotol(srv.URL, graphqltransportwsSubprotocol)
+		defer c.Close()
+
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: graphqltransportwsPingMsg, Payload: json.RawMessage(`{"sent":42}`)}))
+		msg := readOp(c)
+		assert.Equal(t, graphqltransportwsPongMsg, msg.Type)
+		assert.Equal(t, `{"sent":42}`, string(msg.Payload))
+
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: graphqltransportwsConnectionInitMsg}))
+		assert.Equal(t, graphqltransportwsConnectionAckMsg, readOp(c).Type)
+	})
 }
 
 func TestWebsocketWithPingPongInterval(t *testing.T) {
@@ -375,6 +649,47 @@ func TestWebsocketWithPingPongInterval(t *testing.T) {
 	})
 }
 
+func TestWebsocketShutdown(t *testing.T) {
+	h := testserver.New()
+	h.AddTransport(transport.Websocket{})
+	srv := httptest.NewServer(h)
+	defer srv.Close()
+
+	c := wsConnect(srv.URL)
+	defer c.Close()
+
+	require.NoError(t, c.WriteJSON(&operationMessage{Type: connectionInitMsg}))
+	assert.Equal(t, connectionAckMsg, readOp(c).Type)
+	assert.Equal(t, connectionKeepAliveMsg, readOp(c).Type)
+
+	require.NoError(t, c.WriteJSON(&operationMessage{
+		Type:    startMsg,
+		ID:      "test_1",
+		Payload: json.RawMessage(`{"query": "subscription { name }"}`),
+	}))
+	h.SendNextSubscriptionMessage()
+	require.Equal(t, dataMsg, readOp(c).Type)
+
+	shutdown := make(chan error)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		shutdown <- h.Shutdown(ctx)
+	}()
+
+	msg := readOp(c)
+	require.Equal(t, completeMsg, msg.Type)
+	require.Equal(t, "test_1", msg.ID)
+
+	_, _, err := c.ReadMessage()
+	assert.Equal(t, websocket.CloseGoingAway, err.(*websocket.CloseError).Code)
+	require.NoError(t, <-shutdown)
+
+	_, resp, err := websocket.DefaultDialer.Dial(strings.ReplaceAll(srv.URL, "http://", "ws://"), nil)
+	require.Error(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+}
+
 func wsConnect(url string) *websocket.Conn {
 	return wsConnectWithSubprocotol(url, "")
 }
@@ -394,6 +709,21 @@ func wsConnectWithSubprocotol(url, subprocotol string) *websocket.Conn {
 	return c
 }
 
+// requireClose reads the messages of the connection until the server closes it, and checks its close code and reason.
+func requireClose(t *testing.T, conn *websocket.Conn, code int, text string) {
+	t.Helper()
+	for {
+		_, _, err := conn.ReadMessage()
+		if err != nil {
+			var closeErr *websocket.CloseError
+			require.True(t, errors.As(err, &closeErr), "unexpected error %v", err)
+			require.Equal(t, code, closeErr.Code)
+			require.Equal(t, text, closeErr.Text)
+			return
+		}
+	}
+}
+
 func writeRaw(conn *websocket.Conn, msg string) {
 	if err := conn.WriteMessage(websocket.TextMessage, []byte(msg)); err != nil {
 		panic(err)
@@ -0,0 +1,214 @@
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"sync"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// DeferredGroup is a set of fields collected from @defer fragments sharing the same label.
+type DeferredGroup struct {
+	Label        string
+	SelectionSet ast.SelectionSet
+}
+
+// SplitDeferred separates the fields that belong in the current payload from the ones collected from @defer
+// fragments. Deferred fields are grouped by label, and each group is returned as a selection set that can be
+// executed again once the current payload has been resolved.
+func SplitDeferred(fields []CollectedField) ([]CollectedField, []DeferredGroup) {
+	var groups []DeferredGroup
+	var immediate []CollectedField
+	for _, f := range fields {
+		if f.Deferrable == nil {
+			immediate = append(immediate, f)
+			continue
+		}
+
+		field := *f.Field
+		field.SelectionSet = f.Selections
+
+		found := false
+		for i := range groups {
+			if groups[i].Label == f.Deferrable.Label {
+				groups[i].SelectionSet = append(groups[i].SelectionSet, &field)
+				found = true
+				break
+			}
+		}
+		if !found {
+			groups = append(groups, DeferredGroup{
+				Label:        f.Deferrable.Label,
+				SelectionSet: ast.SelectionSet{&field},
+			})
+		}
+	}
+
+	if groups == nil {
+		return fields, nil
+	}
+	return immediate, groups
+}
+
+const streamCtx key = "stream_context"
+
+// StreamList returns the @stream arguments that apply to the list being marshaled in ctx, or nil if the whole list
+// belongs in the current payload. The returned context must be used to marshal the list items, so that lists nested
+// inside a streamed list are never streamed themselves.
+func StreamList(ctx context.Context) (context.Context, *Streamable) {
+	fc := GetFieldContext(ctx)
+	if fc == nil || fc.Index != nil || fc.Field.Streamable == nil {
+		return ctx, nil
+	}
+	if streamed, _ := ctx.Value(streamCtx).(*FieldContext); streamed == fc {
+		return ctx, nil
+	}
+
+	return context.WithValue(ctx, streamCtx, fc), fc.Field.Streamable
+}
+
+// DeferredResults collects the results of @defer fragments and @stream list items, which are resolved in the
+// background and delivered after the initial response of an operation.
+type DeferredResults struct {
+	mu      sync.Mutex
+	used    bool
+	pending int
+	results chan *IncrementalResult
+}
+
+// Defer resolves f in the background and queues its result as the data of the object at the current path.
+func (d *DeferredResults) Defer(ctx context.Context, label string, f func(ctx context.Context) Marshaler) {
+	results := d.add(1)
+
+	go func() {
+		ctx := withSubsequentResponseContext(ctx)
+		result := resolveDeferred(ctx, f)
+
+		var buf bytes.Buffer
+		result.MarshalGQL(&buf)
+
+		d.send(ctx, results, &IncrementalResult{
+			Data:  buf.Bytes(),
+			Label: label,
+		})
+	}()
+}
+
+// Stream resolves the items of a list from index from up to index to in the background, one at a time and in order,
+// and queues each of them as a separate result. f marshals a single item, and is called with a context pointing at
+// the item index.
+func (d *DeferredResults) Stream(ctx context.Context, label string, from, to int, f func(ctx context.Context, i int) Marshaler) {
+	results := d.add(to - from)
+
+	go func() {
+		for i := from; i < to; i++ {
+			i := i
+			ctx := WithFieldContext(withSubsequentResponseContext(ctx), &FieldContext{Index: &i})
+			result := resolveDeferred(ctx, func(ctx context.Context) Marshaler {
+				return f(ctx, i)
+			})
+
+			var buf bytes.Buffer
+			Array{result}.MarshalGQL(&buf)
+
+			if !d.send(ctx, results, &IncrementalResult{
+				Items: buf.Bytes(),
+				Label: label,
+			}) {
+				return
+			}
+		}
+	}()
+}
+
+// HasNext returns the value of hasNext for the response being built, or nil if the operation never deferred any
+// work.
+func (d *DeferredResults) HasNext() *bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if !d.used {
+		return nil
+	}
+	hasNext := d.pending > 0
+	return &hasNext
+}
+
+// Next waits for at least one deferred result and returns a subsequent payload containing every result that is
+// ready. It returns nil once all deferred results have been delivered, or when ctx is done.
+func (d *DeferredResults) Next(ctx context.Context) *Response {
+	d.mu.Lock()
+	pending, results := d.pending, d.results
+	d.mu.Unlock()
+
+	if pending == 0 {
+		return nil
+	}
+
+	var incremental []*IncrementalResult
+	select {
+	case result := <-results:
+		incremental = append(incremental, result)
+	case <-ctx.Done():
+		return nil
+	}
+ready:
+	for {
+		select {
+		case result := <-results:
+			incremental = append(incremental, result)
+		default:
+			break ready
+		}
+	}
+
+	d.mu.Lock()
+	d.pending -= len(incremental)
+	d.mu.Unlock()
+
+	return &Response{
+		Incremental: incremental,
+		HasNext:     d.HasNext(),
+	}
+}
+
+func (d *DeferredResults) add(n int) chan *IncrementalResult {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.results == nil {
+		d.results = make(chan *IncrementalResult)
+	}
+	d.used = true
+	d.pending += n
+	return d.results
+}
+
+func (d *DeferredResults) send(ctx context.Context, results chan *IncrementalResult, result *IncrementalResult) bool {
+	result.Path = GetPath(ctx)
+	if result.Path == nil {
+		result.Path = ast.Path{}
+	}
+	result.Errors = GetErrors(ctx)
+	if ext := getResponseContext(ctx).extensions; len(ext) > 0 {
+		result.Extensions = ext
+	}
+
+	select {
+	case results <- result:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+func resolveDeferred(ctx context.Context, f func(ctx context.Context) Marshaler) (res Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			AddError(ctx, Recover(ctx, r))
+			res = Null
+		}
+	}()
+	return f(ctx)
+}
@@ -0,0 +1,112 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+func TestSplitDeferred(t *testing.T) {
+	deferred := func(label string) ast.DirectiveList {
+		d := &ast.Directive{Name: "defer"}
+		if label != "" {
+			d.Arguments = ast.ArgumentList{{Name: "label", Value: &ast.Value{Kind: ast.StringValue, Raw: label}}}
+		}
+		return ast.DirectiveList{d}
+	}
+	sel := ast.SelectionSet{
+		&ast.Field{Name: "a"},
+		&ast.InlineFragment{
+			Directives:   deferred(""),
+			SelectionSet: ast.SelectionSet{&ast.Field{Name: "a"}, &ast.Field{Name: "b"}},
+		},
+		&ast.InlineFragment{
+			Directives:   deferred("slow"),
+			SelectionSet: ast.SelectionSet{&ast.Field{Name: "c"}},
+		},
+	}
+
+	t.Run("fields are deferred when incremental delivery is enabled", func(t *testing.T) {
+		rc := &OperationContext{IncrementalDelivery: true, Operation: &ast.OperationDefinition{Operation: ast.Query}}
+
+		fields, groups := SplitDeferred(CollectFields(rc, sel, nil))
+		require.Len(t, fields, 1)
+		require.Equal(t, "a", fields[0].Name)
+		require.Len(t, groups, 2)
+		require.Equal(t, "", groups[0].Label)
+		require.Equal(t, "b", groups[0].SelectionSet[0].(*ast.Field).Name)
+		require.Equal(t, "slow", groups[1].Label)
+		require.Equal(t, "c", groups[1].SelectionSet[0].(*ast.Field).Name)
+	})
+
+	t.Run("fields are not deferred without incremental delivery", func(t *testing.T) {
+		rc := &OperationContext{Operation: &ast.OperationDefinition{Operation: ast.Query}}
+
+		fields, groups := SplitDeferred(CollectFields(rc, sel, nil))
+		require.Len(t, fields, 3)
+		require.Nil(t, groups)
+	})
+
+	t.Run("fields are not deferred in subscriptions", func(t *testing.T) {
+		rc := &OperationContext{IncrementalDelivery: true, Operation: &ast.OperationDefinition{Operation: ast.Subscription}}
+
+		fields, groups := SplitDeferred(CollectFields(rc, sel, nil))
+		require.Len(t, fields, 3)
+		require.Nil(t, groups)
+	})
+}
+
+func TestDeferredResults(t *testing.T) {
+	t.Run("has no next payload when nothing was deferred", func(t *testing.T) {
+		d := &DeferredResults{}
+
+		require.Nil(t, d.HasNext())
+		require.Nil(t, d.Next(context.Background()))
+	})
+
+	t.Run("delivers deferred results", func(t *testing.T) {
+		ctx := WithResponseContext(context.Background(), DefaultErrorPresenter, nil)
+		d := &DeferredResults{}
+		d.Defer(ctx, "label", func(ctx context.Context) Marshaler {
+			return MarshalString("value")
+		})
+		require.True(t, *d.HasNext())
+
+		var results []*IncrementalResult
+		for resp := d.Next(ctx); resp != nil; resp = d.Next(ctx) {
+			results = append(results, resp.Incremental...)
+		}
+		require.Len(t, results, 1)
+		require.Equal(t, `"value"`, string(results[0].Data))
+		require.Equal(t, "label", results[0].Label)
+		require.False(t, *d.HasNext())
+	})
+}
+
+func TestResponseMarshalJSON(t *testing.T) {
+	hasNext := false
+
+	t.Run("initial payload", func(t *testing.T) {
+		b, err := json.Marshal(&Response{Data: []byte(`{"a":1}`), HasNext: &hasNext})
+		require.NoError(t, err)
+		require.Equal(t, `{"data":{"a":1},"hasNext":false}`, string(b))
+	})
+
+	t.Run("subsequent payload", func(t *testing.T) {
+		b, err := json.Marshal(&Response{
+			Incremental: []*IncrementalResult{{Data: []byte(`{"b":2}`), Path: ast.Path{ast.PathName("a")}}},
+			HasNext:     &hasNext,
+		})
+		require.NoError(t, err)
+		require.Equal(t, `{"incremental":[{"data":{"b":2},"path":["a"]}],"hasNext":false}`, string(b))
+	})
+
+	t.Run("response without incremental delivery", func(t *testing.T) {
+		b, err := json.Marshal(&Response{Data: []byte(`null`)})
+		require.NoError(t, err)
+		require.Equal(t, `{"data":null}`, string(b))
+	})
+}
@@ -0,0 +1,209 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// DefaultLoaderWait is how long loaders wait for more keys before fetching a batch, unless configured otherwise.
+const DefaultLoaderWait = time.Millisecond
+
+// LoaderConfig configures a Loader.
+type LoaderConfig[K comparable, V any] struct {
+	// Fetch returns one value per key, in the same order. The errors are either nil, a single error for the whole
+	// batch, or one error per key. The context is the one of the load that started the batch.
+	Fetch func(ctx context.Context, keys []K) ([]V, []error)
+
+	// Wait is how long to wait for more keys before fetching a batch, defaults to DefaultLoaderWait.
+	Wait time.Duration
+
+	// MaxBatch limits the number of keys fetched at once, 0 means no limit.
+	MaxBatch int
+}
+
+// Loader batches the loads of many keys into calls to a fetch function, and caches their values. Loaders are meant
+// to live as long as a request, so the cache never serves the values of another request.
+type Loader[K comparable, V any] struct {
+	fetch    func(ctx context.Context, keys []K) ([]V, []error)
+	wait     time.Duration
+	maxBatch int
+
+	mu    sync.Mutex
+	cache map[K]V
+
+	// batch collects the keys until the wait is over or it is full, then it is fetched
+	batch *loaderBatch[K, V]
+}
+
+type loaderBatch[K comparable, V any] struct {
+	keys    []K
+	values  []V
+	errs    []error
+	closing bool
+	done    chan struct{}
+}
+
+func NewLoader[K comparable, V any](config LoaderConfig[K, V]) *Loader[K, V] {
+	if config.Fetch == nil {
+		panic("loaders need a fetch function")
+	}
+	if config.Wait == 0 {
+		config.Wait = DefaultLoaderWait
+	}
+	return &Loader[K, V]{
+		fetch:    config.Fetch,
+		wait:     config.Wait,
+		maxBatch: config.MaxBatch,
+	}
+}
+
+// Load returns the value of key, fetching it in a batch with the keys loaded meanwhile unless it is cached.
+func (l *Loader[K, V]) Load(ctx context.Context, key K) (V, error) {
+	return l.LoadThunk(ctx, key)()
+}
+
+// LoadThunk adds key to the current batch, and returns a function waiting for its value. It allows loading many
+// keys from one goroutine without waiting for each of them.
+func (l *Loader[K, V]) LoadThunk(ctx context.Context, key K) func() (V, error) {
+	l.mu.Lock()
+	if v, ok := l.cache[key]; ok {
+		l.mu.Unlock()
+		return func() (V, error) {
+			return v, nil
+		}
+	}
+	if l.batch == nil {
+		l.batch = &loaderBatch[K, V]{done: make(chan struct{})}
+	}
+	batch := l.batch
+	pos := l.add(ctx, batch, key)
+	l.mu.Unlock()
+
+	return func() (V, error) {
+		<-batch.done
+
+		var v V
+		if pos < len(batch.values) {
+			v = batch.values[pos]
+		}
+
+		// fetches returning the wrong number of values or errors fail for every key, so they are never cached
+		var err error
+		switch {
+		case len(batch.errs) == 1:
+			err = batch.errs[0]
+		case len(batch.errs) != 0 && len(batch.errs) != len(batch.keys):
+			err = fmt.Errorf("the loader fetched %d errors for %d keys", len(batch.errs), len(batch.keys))
+		case len(batch.errs) != 0 && batch.errs[pos] != nil:
+			err = batch.errs[pos]
+		case len(batch.values) != len(batch.keys):
+			err = fmt.Errorf("the loader fetched %d values for %d keys", len(batch.values), len(batch.keys))
+		}
+
+		if err == nil {
+			l.mu.Lock()
+			l.set(key, v)
+			l.mu.Unlock()
+		}
+		return v, err
+	}
+}
+
+// LoadAll loads many keys at once, in as many batches as the batch size allows.
+func (l *Loader[K, V]) LoadAll(ctx context.Context, keys []K) ([]V, []error) {
+	thunks := make([]func() (V, error), len(keys))
+	for i, key := range keys {
+		thunks[i] = l.LoadThunk(ctx, key)
+	}
+
+	values := make([]V, len(keys))
+	errs := make([]error, len(keys))
+	for i, thunk := range thunks {
+		values[i], errs[i] = thunk()
+	}
+	return values, errs
+}
+
+// Prime caches the value of key, unless it is cached already. It returns whether the value was cached.
+func (l *Loader[K, V]) Prime(key K, value V) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, found := l.cache[key]; found {
+		return false
+	}
+	l.set(key, value)
+	return true
+}
+
+// Clear removes the value of key from the cache, so it is fetched again on its next load.
+func (l *Loader[K, V]) Clear(key K) {
+	l.mu.Lock()
+	delete(l.cache, key)
+	l.mu.Unlock()
+}
+
+// ClearAll empties the cache.
+func (l *Loader[K, V]) ClearAll() {
+	l.mu.Lock()
+	l.cache = nil
+	l.mu.Unlock()
+}
+
+func (l *Loader[K, V]) set(key K, value V) {
+	if l.cache == nil {
+		l.cache = map[K]V{}
+	}
+	l.cache[key] = value
+}
+
+// add returns the position of key in the batch, adding it if needed. The first key starts the timer of the batch,
+// and the last one it can take fetches it right away.
+func (l *Loader[K, V]) add(ctx context.Context, b *loaderBatch[K, V], key K) int {
+	for i, existing := range b.keys {
+		if existing == key {
+			return i
+		}
+	}
+
+	pos := len(b.keys)
+	b.keys = append(b.keys, key)
+	if pos == 0 {
+		go l.startTimer(ctx, b)
+	}
+
+	if l.maxBatch != 0 && pos >= l.maxBatch-1 && !b.closing {
+		b.closing = true
+		l.batch = nil
+		go l.end(ctx, b)
+	}
+	return pos
+}
+
+func (l *Loader[K, V]) startTimer(ctx context.Context, b *loaderBatch[K, V]) {
+	time.Sleep(l.wait)
+
+	l.mu.Lock()
+	// the batch is full and being fetched already
+	if b.closing {
+		l.mu.Unlock()
+		return
+	}
+	b.closing = true
+	l.batch = nil
+	l.mu.Unlock()
+
+	l.end(ctx, b)
+}
+
+func (l *Loader[K, V]) end(ctx context.Context, b *loaderBatch[K, V]) {
+	defer close(b.done)
+	defer func() {
+		if r := recover(); r != nil {
+			b.values = nil
+			b.errs = []error{fmt.Errorf("the loader panicked: %v", r)}
+		}
+	}()
+	b.values, b.errs = l.fetch(ctx, b.keys)
+}
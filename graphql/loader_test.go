@@ -0,0 +1,179 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type fetches struct {
+	mu      sync.Mutex
+	batches [][]int
+}
+
+func (f *fetches) loader(config LoaderConfig[int, string]) *Loader[int, string] {
+	config.Fetch = func(ctx context.Context, keys []int) ([]string, []error) {
+		f.mu.Lock()
+		f.batches = append(f.batches, keys)
+		f.mu.Unlock()
+
+		values := make([]string, len(keys))
+		errs := make([]error, len(keys))
+		for i, key := range keys {
+			if key < 0 {
+				errs[i] = fmt.Errorf("%d is negative", key)
+				continue
+			}
+			values[i] = strconv.Itoa(key)
+		}
+		return values, errs
+	}
+	return NewLoader(config)
+}
+
+func TestLoader(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("batches concurrent loads", func(t *testing.T) {
+		f := &fetches{}
+		l := f.loader(LoaderConfig[int, string]{Wait: 10 * time.Millisecond})
+
+		var wg sync.WaitGroup
+		values := make([]string, 5)
+		for i := range values {
+			i := i
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				v, err := l.Load(ctx, i%3)
+				require.NoError(t, err)
+				values[i] = v
+			}()
+		}
+		wg.Wait()
+
+		require.Equal(t, []string{"0", "1", "2", "0", "1"}, values)
+		require.Len(t, f.batches, 1)
+		require.ElementsMatch(t, []int{0, 1, 2}, f.batches[0])
+	})
+
+	t.Run("limits the size of batches", func(t *testing.T) {
+		f := &fetches{}
+		l := f.loader(LoaderConfig[int, string]{Wait: time.Hour, MaxBatch: 2})
+
+		values, errs := l.LoadAll(ctx, []int{1, 2, 3, 4})
+		require.Equal(t, []string{"1", "2", "3", "4"}, values)
+		require.Equal(t, []error{nil, nil, nil, nil}, errs)
+		require.ElementsMatch(t, [][]int{{1, 2}, {3, 4}}, f.batches)
+	})
+
+	t.Run("caches values but not errors", func(t *testing.T) {
+		f := &fetches{}
+		l := f.loader(LoaderConfig[int, string]{})
+
+		_, errs := l.LoadAll(ctx, []int{1, -1})
+		require.EqualError(t, errs[1], "-1 is negative")
+
+		_, errs = l.LoadAll(ctx, []int{1, -1})
+		require.EqualError(t, errs[1], "-1 is negative")
+		require.Equal(t, [][]int{{1, -1}, {-1}}, f.batches)
+	})
+
+	t.Run("primes and clears the cache", func(t *testing.T) {
+		f := &fetches{}
+		l := f.loader(LoaderConfig[int, string]{})
+
+		require.True(t, l.Prime(1, "one"))
+		require.False(t, l.Prime(1, "uno"))
+		v, err := l.Load(ctx, 1)
+		require.NoError(t, err)
+		require.Equal(t, "one", v)
+		require.Empty(t, f.batches)
+
+		l.Clear(1)
+		v, _ = l.Load(ctx, 1)
+		require.Equal(t, "1", v)
+
+		l.ClearAll()
+		v, _ = l.Load(ctx, 1)
+		require.Equal(t, "1", v)
+		require.Equal(t, [][]int{{1}, {1}}, f.batches)
+	})
+
+	t.Run("applies a single error to the whole batch", func(t *testing.T) {
+		l := NewLoader(LoaderConfig[int, string]{Fetch: func(ctx context.Context, keys []int) ([]string, []error) {
+			return nil, []error{errors.New("db is down")}
+		}})
+
+		_, errs := l.LoadAll(ctx, []int{1, 2})
+		require.EqualError(t, errs[0], "db is down")
+		require.EqualError(t, errs[1], "db is down")
+	})
+
+	t.Run("checks the number of values", func(t *testing.T) {
+		l := NewLoader(LoaderConfig[int, string]{Fetch: func(ctx context.Context, keys []int) ([]string, []error) {
+			return []string{"1"}, nil
+		}})
+
+		_, errs := l.LoadAll(ctx, []int{1, 2})
+		require.EqualError(t, errs[1], "the loader fetched 1 values for 2 keys")
+	})
+
+	t.Run("checks the number of values and errors without caching them", func(t *testing.T) {
+		var fetched [][]int
+		l := NewLoader(LoaderConfig[int, string]{Fetch: func(ctx context.Context, keys []int) ([]string, []error) {
+			fetched = append(fetched, keys)
+			if len(keys) == 3 {
+				return []string{"1", "2"}, []error{nil, nil}
+			}
+			return []string{"1"}, []error{nil, nil}
+		}})
+
+		_, errs := l.LoadAll(ctx, []int{1, 2, 3})
+		for _, err := range errs {
+			require.EqualError(t, err, "the loader fetched 2 errors for 3 keys")
+		}
+
+		_, errs = l.LoadAll(ctx, []int{1, 2})
+		for _, err := range errs {
+			require.EqualError(t, err, "the loader fetched 1 values for 2 keys")
+		}
+		require.Equal(t, [][]int{{1, 2, 3}, {1, 2}}, fetched)
+	})
+
+	t.Run("recovers from panics in fetch", func(t *testing.T) {
+		l := NewLoader(LoaderConfig[int, string]{Fetch: func(ctx context.Context, keys []int) ([]string, []error) {
+			panic("boom")
+		}})
+
+		_, err := l.Load(ctx, 1)
+		require.EqualError(t, err, "the loader panicked: boom")
+	})
+}
+
+func TestGetLoader(t *testing.T) {
+	loaders := NewLoaders()
+	AddLoader(loaders, "byID", LoaderConfig[int, string]{Fetch: func(ctx context.Context, keys []int) ([]string, []error) {
+		return make([]string, len(keys)), nil
+	}})
+	ctx := WithLoaders(context.Background(), loaders)
+
+	l := GetLoader[int, string](ctx, "byID")
+	require.Same(t, l, GetLoader[int, string](ctx, "byID"))
+
+	require.PanicsWithError(t, "there is no loader called byName", func() {
+		GetLoader[int, string](ctx, "byName")
+	})
+	require.PanicsWithError(t, "the loader called byID is a *graphql.Loader[int,string], not a *graphql.Loader[string,string]", func() {
+		GetLoader[string, string](ctx, "byID")
+	})
+	require.PanicsWithValue(t, "missing loaders in context, add the Loaders extension to the server", func() {
+		GetLoader[int, string](context.Background(), "byID")
+	})
+}
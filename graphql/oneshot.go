@@ -14,3 +14,19 @@ func OneShot(resp *Response) ResponseHandler {
 		return resp
 	}
 }
+
+const precomputedResponseCtx key = "precomputed_response_context"
+
+// WithPrecomputedResponse makes the executor answer the operation with resp instead of executing it. Operation
+// interceptors answering from a cache use it so that the response still goes through the response interceptors.
+func WithPrecomputedResponse(ctx context.Context, resp *Response) context.Context {
+	return context.WithValue(ctx, precomputedResponseCtx, resp)
+}
+
+// GetPrecomputedResponse returns the response the operation is answered with, if an interceptor computed it already.
+func GetPrecomputedResponse(ctx context.Context) *Response {
+	if val, ok := ctx.Value(precomputedResponseCtx).(*Response); ok {
+		return val
+	}
+	return nil
+}
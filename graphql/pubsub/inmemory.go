@@ -0,0 +1,65 @@
+package pubsub
+
+import (
+	"context"
+	"sync"
+)
+
+// InMemory is a Broker delivering the messages to the subscribers of the same process.
+type InMemory struct {
+	mu     sync.RWMutex
+	topics map[string]map[*inMemorySubscriber]struct{}
+}
+
+type inMemorySubscriber struct {
+	deliver func(msg interface{})
+}
+
+var _ Broker = &InMemory{}
+
+func NewInMemory() *InMemory {
+	return &InMemory{
+		topics: map[string]map[*inMemorySubscriber]struct{}{},
+	}
+}
+
+func (b *InMemory) Publish(ctx context.Context, topic string, msg interface{}) error {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for s := range b.topics[topic] {
+		s.deliver(msg)
+	}
+	return nil
+}
+
+func (b *InMemory) Subscribe(ctx context.Context, topic string, deliver func(msg interface{})) error {
+	s := &inMemorySubscriber{deliver: deliver}
+
+	b.mu.Lock()
+	if b.topics[topic] == nil {
+		b.topics[topic] = map[*inMemorySubscriber]struct{}{}
+	}
+	b.topics[topic][s] = struct{}{}
+	b.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		delete(b.topics[topic], s)
+		if len(b.topics[topic]) == 0 {
+			delete(b.topics, topic)
+		}
+	}()
+
+	return nil
+}
+
+// Subscribers returns the number of current subscribers of topic.
+func (b *InMemory) Subscribers(topic string) int {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return len(b.topics[topic])
+}
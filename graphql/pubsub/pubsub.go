@@ -0,0 +1,207 @@
+// Package pubsub delivers the events published by mutations, or anything else, to subscription resolvers.
+//
+// Messages are published on typed topics. Every subscriber gets its own buffered channel, which is closed once the
+// context of the subscription is done, so subscription resolvers can return it as is:
+//
+//	var MessageAdded = pubsub.NewTopic[*model.Message](broker, "messageAdded")
+//
+//	func (r *subscriptionResolver) MessageAdded(ctx context.Context, room string) (<-chan *model.Message, error) {
+//		return MessageAdded.Subscribe(ctx, pubsub.Filter(func(m *model.Message) bool {
+//			return m.Room == room
+//		}))
+//	}
+package pubsub
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// Broker carries the messages published on topics to their subscribers. InMemory delivers them within the process,
+// other implementations can share them between the instances of a server.
+type Broker interface {
+	// Publish delivers msg to every current subscriber of topic.
+	Publish(ctx context.Context, topic string, msg interface{}) error
+
+	// Subscribe calls deliver with every message published on topic, until ctx is done. deliver doesn't block, and
+	// can be called concurrently. Brokers sending messages to other processes must hand back values of the type they
+	// were published with.
+	Subscribe(ctx context.Context, topic string, deliver func(msg interface{})) error
+}
+
+// Overflow tells what happens to the messages delivered to a subscriber whose buffer is full.
+type Overflow int
+
+const (
+	// DropOldest discards the oldest buffered message to make room for the new one.
+	DropOldest Overflow = iota
+	// DropNewest discards the new message.
+	DropNewest
+	// Disconnect ends the subscription: the subscriber reads the messages already buffered, then its channel is
+	// closed.
+	Disconnect
+)
+
+func (o Overflow) String() string {
+	switch o {
+	case DropOldest:
+		return "drop oldest"
+	case DropNewest:
+		return "drop newest"
+	case Disconnect:
+		return "disconnect"
+	default:
+		return fmt.Sprintf("Overflow(%d)", int(o))
+	}
+}
+
+// DefaultBufferSize is the number of messages buffered for a subscriber when Buffer isn't used.
+const DefaultBufferSize = 16
+
+type options struct {
+	buffer   int
+	overflow Overflow
+	filter   func(msg interface{}) bool
+}
+
+// Option configures a subscription.
+type Option func(o *options)
+
+// Buffer sets the number of messages buffered for the subscriber, until it reads them.
+func Buffer(size int) Option {
+	return func(o *options) {
+		o.buffer = size
+	}
+}
+
+// OnOverflow sets what happens to the messages delivered while the buffer of the subscriber is full, DropOldest by
+// default.
+func OnOverflow(overflow Overflow) Option {
+	return func(o *options) {
+		o.overflow = overflow
+	}
+}
+
+// Filter only delivers the messages accepted by filter, typically comparing them with the arguments of the
+// subscription field. Filters of several Filter options must all accept a message.
+func Filter[T any](filter func(msg T) bool) Option {
+	return func(o *options) {
+		previous := o.filter
+		o.filter = func(msg interface{}) bool {
+			if previous != nil && !previous(msg) {
+				return false
+			}
+			m, ok := msg.(T)
+			return ok && filter(m)
+		}
+	}
+}
+
+// Topic is a named stream of messages of type T.
+type Topic[T any] struct {
+	Name   string
+	Broker Broker
+}
+
+// NewTopic creates the topic name of broker.
+func NewTopic[T any](broker Broker, name string) Topic[T] {
+	return Topic[T]{Name: name, Broker: broker}
+}
+
+// Publish delivers msg to the current subscribers of the topic.
+func (t Topic[T]) Publish(ctx context.Context, msg T) error {
+	return t.Broker.Publish(ctx, t.Name, msg)
+}
+
+// Subscribe returns a channel receiving the messages published on the topic from now on. The subscription ends, and
+// the channel is closed, once ctx is done.
+func (t Topic[T]) Subscribe(ctx context.Context, opts ...Option) (<-chan T, error) {
+	o := options{buffer: DefaultBufferSize, overflow: DropOldest}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.buffer <= 0 {
+		return nil, fmt.Errorf("the buffer of subscribers must be positive, got %d", o.buffer)
+	}
+	if o.overflow < DropOldest || o.overflow > Disconnect {
+		return nil, fmt.Errorf("unknown overflow policy %s", o.overflow)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	s := &subscriber[T]{
+		options: o,
+		out:     make(chan T, o.buffer),
+		cancel:  cancel,
+	}
+	if err := t.Broker.Subscribe(ctx, t.Name, s.deliver); err != nil {
+		cancel()
+		return nil, err
+	}
+
+	go func() {
+		<-ctx.Done()
+		s.close()
+	}()
+
+	return s.out, nil
+}
+
+// subscriber buffers the messages of a subscription until they are read from out.
+type subscriber[T any] struct {
+	options
+	out    chan T
+	cancel context.CancelFunc
+
+	mu     sync.Mutex
+	closed bool
+}
+
+func (s *subscriber[T]) deliver(msg interface{}) {
+	if s.filter != nil && !s.filter(msg) {
+		return
+	}
+	m, ok := msg.(T)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return
+	}
+
+	select {
+	case s.out <- m:
+		return
+	default:
+	}
+
+	switch s.overflow {
+	case DropOldest:
+		// the subscriber may have read a message in the meantime, leaving room for this one
+		select {
+		case <-s.out:
+		default:
+		}
+		select {
+		case s.out <- m:
+		default:
+		}
+	case DropNewest:
+	case Disconnect:
+		s.closed = true
+		close(s.out)
+		s.cancel()
+	}
+}
+
+func (s *subscriber[T]) close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.closed {
+		s.closed = true
+		close(s.out)
+	}
+}
@@ -0,0 +1,184 @@
+package pubsub
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type message struct {
+	Room string
+	Text string
+}
+
+func receive(t *testing.T, ch <-chan *message) *message {
+	t.Helper()
+	select {
+	case m, ok := <-ch:
+		require.True(t, ok, "the channel is closed")
+		return m
+	case <-time.After(time.Second):
+		t.Fatal("no message received")
+		return nil
+	}
+}
+
+func requireClosed(t *testing.T, ch <-chan *message) {
+	t.Helper()
+	select {
+	case m, ok := <-ch:
+		require.False(t, ok, "unexpected message %v", m)
+	case <-time.After(time.Second):
+		t.Fatal("the channel is not closed")
+	}
+}
+
+func TestTopic(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("delivers messages to every subscriber", func(t *testing.T) {
+		topic := NewTopic[*message](NewInMemory(), "messages")
+
+		a, err := topic.Subscribe(ctx)
+		require.NoError(t, err)
+		b, err := topic.Subscribe(ctx)
+		require.NoError(t, err)
+
+		require.NoError(t, topic.Publish(ctx, &message{Text: "hello"}))
+		require.Equal(t, "hello", receive(t, a).Text)
+		require.Equal(t, "hello", receive(t, b).Text)
+	})
+
+	t.Run("topics are separate", func(t *testing.T) {
+		broker := NewInMemory()
+		messages := NewTopic[*message](broker, "messages")
+		others := NewTopic[*message](broker, "others")
+
+		ch, err := messages.Subscribe(ctx)
+		require.NoError(t, err)
+
+		require.NoError(t, others.Publish(ctx, &message{Text: "other"}))
+		require.NoError(t, messages.Publish(ctx, &message{Text: "hello"}))
+		require.Equal(t, "hello", receive(t, ch).Text)
+	})
+
+	t.Run("filters messages", func(t *testing.T) {
+		topic := NewTopic[*message](NewInMemory(), "messages")
+
+		ch, err := topic.Subscribe(ctx,
+			Filter(func(m *message) bool { return m.Room == "gophers" }),
+			Filter(func(m *message) bool { return m.Text != "spam" }),
+		)
+		require.NoError(t, err)
+
+		require.NoError(t, topic.Publish(ctx, &message{Room: "rustaceans", Text: "hello"}))
+		require.NoError(t, topic.Publish(ctx, &message{Room: "gophers", Text: "spam"}))
+		require.NoError(t, topic.Publish(ctx, &message{Room: "gophers", Text: "hello"}))
+		require.Equal(t, &message{Room: "gophers", Text: "hello"}, receive(t, ch))
+	})
+
+	t.Run("unsubscribes when the context is done", func(t *testing.T) {
+		broker := NewInMemory()
+		topic := NewTopic[*message](broker, "messages")
+
+		ctx, cancel := context.WithCancel(ctx)
+		ch, err := topic.Subscribe(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, broker.Subscribers("messages"))
+
+		cancel()
+		requireClosed(t, ch)
+		require.Eventually(t, func() bool {
+			return broker.Subscribers("messages") == 0
+		}, time.Second, time.Millisecond)
+		require.NoError(t, topic.Publish(context.Background(), &message{Text: "hello"}))
+	})
+
+	t.Run("drops the oldest messages", func(t *testing.T) {
+		topic := NewTopic[*message](NewInMemory(), "messages")
+
+		ch, err := topic.Subscribe(ctx, Buffer(2))
+		require.NoError(t, err)
+
+		for _, text := range []string{"1", "2", "3"} {
+			require.NoError(t, topic.Publish(ctx, &message{Text: text}))
+		}
+		require.Equal(t, "2", receive(t, ch).Text)
+		require.Equal(t, "3", receive(t, ch).Text)
+	})
+
+	t.Run("drops the newest messages", func(t *testing.T) {
+		topic := NewTopic[*message](NewInMemory(), "messages")
+
+		ch, err := topic.Subscribe(ctx, Buffer(2), OnOverflow(DropNewest))
+		require.NoError(t, err)
+
+		for _, text := range []string{"1", "2", "3"} {
+			require.NoError(t, topic.Publish(ctx, &message{Text: text}))
+		}
+		require.Equal(t, "1", receive(t, ch).Text)
+		require.Equal(t, "2", receive(t, ch).Text)
+
+		require.NoError(t, topic.Publish(ctx, &message{Text: "4"}))
+		require.Equal(t, "4", receive(t, ch).Text)
+	})
+
+	t.Run("disconnects slow subscribers", func(t *testing.T) {
+		broker := NewInMemory()
+		topic := NewTopic[*message](broker, "messages")
+
+		ch, err := topic.Subscribe(ctx, Buffer(2), OnOverflow(Disconnect))
+		require.NoError(t, err)
+
+		for _, text := range []string{"1", "2", "3", "4"} {
+			require.NoError(t, topic.Publish(ctx, &message{Text: text}))
+		}
+		require.Equal(t, "1", receive(t, ch).Text)
+		require.Equal(t, "2", receive(t, ch).Text)
+		requireClosed(t, ch)
+		require.Eventually(t, func() bool {
+			return broker.Subscribers("messages") == 0
+		}, time.Second, time.Millisecond)
+	})
+
+	t.Run("ignores messages of other types", func(t *testing.T) {
+		broker := NewInMemory()
+		topic := NewTopic[*message](broker, "messages")
+
+		ch, err := topic.Subscribe(ctx)
+		require.NoError(t, err)
+
+		require.NoError(t, broker.Publish(ctx, "messages", "hello"))
+		require.NoError(t, topic.Publish(ctx, &message{Text: "hello"}))
+		require.Equal(t, "hello", receive(t, ch).Text)
+	})
+
+	t.Run("validates options", func(t *testing.T) {
+		topic := NewTopic[*message](NewInMemory(), "messages")
+
+		_, err := topic.Subscribe(ctx, Buffer(0))
+		require.EqualError(t, err, "the buffer of subscribers must be positive, got 0")
+
+		_, err = topic.Subscribe(ctx, OnOverflow(Overflow(42)))
+		require.EqualError(t, err, "unknown overflow policy Overflow(42)")
+	})
+
+	t.Run("delivers concurrent messages", func(t *testing.T) {
+		topic := NewTopic[*message](NewInMemory(), "messages")
+
+		ch, err := topic.Subscribe(ctx, Buffer(100))
+		require.NoError(t, err)
+
+		for i := 0; i < 100; i++ {
+			go func() {
+				assert.NoError(t, topic.Publish(ctx, &message{Text: "hello"}))
+			}()
+		}
+		for i := 0; i < 100; i++ {
+			receive(t, ch)
+		}
+	})
+}
@@ -1,10 +1,12 @@
 package graphql
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 
+	"github.com/vektah/gqlparser/v2/ast"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
@@ -12,11 +14,56 @@ import (
 // https://github.com/facebook/graphql/commit/7b40390d48680b15cb93e02d46ac5eb249689876#diff-757cea6edf0288677a9eea4cfc801d87R107
 // and https://github.com/facebook/graphql/pull/384
 type Response struct {
+	Errors gqlerror.List   `json:"errors,omitempty"`
+	Data   json.RawMessage `json:"data"`
+	// Incremental holds the @defer and @stream results delivered by a subsequent payload of an operation.
+	Incremental []*IncrementalResult `json:"incremental,omitempty"`
+	// HasNext is only set when the operation uses incremental delivery, and tells the client whether more payloads
+	// will follow this one. Responses that have HasNext set but no Data are subsequent payloads, and are serialized
+	// without the data key.
+	HasNext    *bool                  `json:"hasNext,omitempty"`
+	Extensions map[string]interface{} `json:"extensions,omitempty"`
+	// StreamedData is set instead of Data when the operation context asks for the data to be streamed, and is only
+	// written once the response is. Responses with StreamedData are still marshaled like the ones with Data.
+	StreamedData Marshaler `json:"-"`
+}
+
+// IncrementalResult is the result of a @defer fragment or of a @stream list item, sent after the initial response
+// of an operation. Deferred fragments are sent in Data, streamed list items in Items.
+type IncrementalResult struct {
 	Errors     gqlerror.List          `json:"errors,omitempty"`
-	Data       json.RawMessage        `json:"data"`
+	Data       json.RawMessage        `json:"data,omitempty"`
+	Items      json.RawMessage        `json:"items,omitempty"`
+	Path       ast.Path               `json:"path"`
+	Label      string                 `json:"label,omitempty"`
 	Extensions map[string]interface{} `json:"extensions,omitempty"`
 }
 
+func (r Response) MarshalJSON() ([]byte, error) {
+	if r.StreamedData != nil {
+		var buf bytes.Buffer
+		r.StreamedData.MarshalGQL(&buf)
+		r.Data = buf.Bytes()
+	}
+
+	if r.HasNext != nil && r.Data == nil {
+		return json.Marshal(struct {
+			Errors      gqlerror.List          `json:"errors,omitempty"`
+			Incremental []*IncrementalResult   `json:"incremental,omitempty"`
+			HasNext     bool                   `json:"hasNext"`
+			Extensions  map[string]interface{} `json:"extensions,omitempty"`
+		}{
+			Errors:      r.Errors,
+			Incremental: r.Incremental,
+			HasNext:     *r.HasNext,
+			Extensions:  r.Extensions,
+		})
+	}
+
+	type response Response
+	return json.Marshal(response(r))
+}
+
 func ErrorResponse(ctx context.Context, messagef string, args ...interface{}) *Response {
 	return &Response{
 		Errors: gqlerror.List{{Message: fmt.Sprintf(messagef, args...)}},
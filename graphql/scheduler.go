@@ -0,0 +1,150 @@
+package graphql
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+)
+
+// ConcurrencyLimit bounds the number of goroutines resolving fields at once. A nil limit doesn't bound anything.
+type ConcurrencyLimit chan struct{}
+
+// NewConcurrencyLimit creates a limit of n goroutines, or no limit when n is not positive.
+func NewConcurrencyLimit(n int) ConcurrencyLimit {
+	if n <= 0 {
+		return nil
+	}
+	return make(ConcurrencyLimit, n)
+}
+
+func (l ConcurrencyLimit) tryAcquire() bool {
+	if l == nil {
+		return true
+	}
+	select {
+	case l <- struct{}{}:
+		return true
+	default:
+		return false
+	}
+}
+
+func (l ConcurrencyLimit) release() {
+	if l != nil {
+		<-l
+	}
+}
+
+// Scheduler starts the goroutines resolving the fields and list items of an operation. Once its limits are reached,
+// the work is done by the goroutine scheduling it instead, so the fields being resolved never wait for others to
+// finish before they can start, and the limits can't deadlock nested fields.
+//
+// A nil Scheduler starts a goroutine for everything it is given.
+type Scheduler struct {
+	operation ConcurrencyLimit
+	server    ConcurrencyLimit
+	inline    func(field CollectedField) bool
+
+	running int64
+	peak    int64
+	started int64
+}
+
+// NewScheduler creates the scheduler of an operation. The operation limit is only used by this scheduler while the
+// server one can be shared by the schedulers of all operations, and inline tells which fields are cheap enough to be
+// resolved without a goroutine of their own. All of them are optional.
+func NewScheduler(operation, server ConcurrencyLimit, inline func(field CollectedField) bool) *Scheduler {
+	return &Scheduler{
+		operation: operation,
+		server:    server,
+		inline:    inline,
+	}
+}
+
+// Go calls f, in a new goroutine if the limits allow it. wg is done once f returns.
+func (s *Scheduler) Go(wg *sync.WaitGroup, f func()) {
+	wg.Add(1)
+	if !s.acquire() {
+		defer wg.Done()
+		f()
+		return
+	}
+
+	go func() {
+		defer wg.Done()
+		defer s.release()
+		f()
+	}()
+}
+
+// Inline tells whether field should be resolved without a goroutine of its own.
+func (s *Scheduler) Inline(field CollectedField) bool {
+	return s != nil && s.inline != nil && s.inline(field)
+}
+
+// Peak returns the highest number of goroutines resolving fields at once for the operation so far.
+func (s *Scheduler) Peak() int {
+	if s == nil {
+		return 0
+	}
+	return int(atomic.LoadInt64(&s.peak))
+}
+
+// Started returns the number of goroutines started for the operation so far.
+func (s *Scheduler) Started() int {
+	if s == nil {
+		return 0
+	}
+	return int(atomic.LoadInt64(&s.started))
+}
+
+// SchedulerStats are the metrics of the scheduler of an operation.
+type SchedulerStats struct {
+	// Peak is the highest number of goroutines resolving fields at once.
+	Peak int
+
+	// Started is the number of goroutines started.
+	Started int
+}
+
+// GetSchedulerStats returns the metrics of the scheduler of the operation so far, so that tracers and extensions can
+// report them once the operation is done. It is nil outside of operations.
+func GetSchedulerStats(ctx context.Context) *SchedulerStats {
+	if !HasOperationContext(ctx) {
+		return nil
+	}
+	s := GetOperationContext(ctx).Scheduler
+	return &SchedulerStats{Peak: s.Peak(), Started: s.Started()}
+}
+
+func (s *Scheduler) acquire() bool {
+	if s == nil {
+		return true
+	}
+	if !s.operation.tryAcquire() {
+		return false
+	}
+	if !s.server.tryAcquire() {
+		s.operation.release()
+		return false
+	}
+
+	atomic.AddInt64(&s.started, 1)
+	running := atomic.AddInt64(&s.running, 1)
+	for {
+		peak := atomic.LoadInt64(&s.peak)
+		if running <= peak || atomic.CompareAndSwapInt64(&s.peak, peak, running) {
+			break
+		}
+	}
+	return true
+}
+
+func (s *Scheduler) release() {
+	if s == nil {
+		return
+	}
+	atomic.AddInt64(&s.running, -1)
+	s.server.release()
+	s.operation.release()
+}
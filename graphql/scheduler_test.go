@@ -0,0 +1,103 @@
+package graphql
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+func TestScheduler(t *testing.T) {
+	run := func(s *Scheduler, n int) (concurrency int64) {
+		var running int64
+		var wg sync.WaitGroup
+		for i := 0; i < n; i++ {
+			s.Go(&wg, func() {
+				now := atomic.AddInt64(&running, 1)
+				for {
+					peak := atomic.LoadInt64(&concurrency)
+					if now <= peak || atomic.CompareAndSwapInt64(&concurrency, peak, now) {
+						break
+					}
+				}
+				time.Sleep(5 * time.Millisecond)
+				atomic.AddInt64(&running, -1)
+			})
+		}
+		wg.Wait()
+		return concurrency
+	}
+
+	t.Run("starts a goroutine for everything without limits", func(t *testing.T) {
+		s := NewScheduler(nil, nil, nil)
+		require.EqualValues(t, 10, run(s, 10))
+		require.Equal(t, 10, s.Peak())
+		require.Equal(t, 10, s.Started())
+	})
+
+	t.Run("runs the rest in the calling goroutine once the limit is reached", func(t *testing.T) {
+		s := NewScheduler(NewConcurrencyLimit(3), nil, nil)
+		require.LessOrEqual(t, run(s, 20), int64(4))
+		require.Equal(t, 3, s.Peak())
+		require.Less(t, s.Started(), 20)
+	})
+
+	t.Run("shares the server limit", func(t *testing.T) {
+		server := NewConcurrencyLimit(2)
+		a := NewScheduler(NewConcurrencyLimit(5), server, nil)
+		b := NewScheduler(NewConcurrencyLimit(5), server, nil)
+
+		var wg sync.WaitGroup
+		wg.Add(2)
+		go func() { run(a, 10); wg.Done() }()
+		go func() { run(b, 10); wg.Done() }()
+		wg.Wait()
+
+		require.LessOrEqual(t, a.Peak(), 2)
+		require.LessOrEqual(t, b.Peak(), 2)
+		require.Len(t, server, 0, "the slots are released")
+	})
+
+	t.Run("nil schedulers don't limit anything", func(t *testing.T) {
+		var s *Scheduler
+		require.EqualValues(t, 5, run(s, 5))
+		require.Equal(t, 0, s.Peak())
+		require.False(t, s.Inline(CollectedField{Field: &ast.Field{Name: "name"}}))
+	})
+
+	t.Run("stats", func(t *testing.T) {
+		require.Nil(t, GetSchedulerStats(context.Background()))
+
+		s := NewScheduler(nil, nil, nil)
+		run(s, 3)
+		ctx := WithOperationContext(context.Background(), &OperationContext{Scheduler: s})
+		require.Equal(t, &SchedulerStats{Peak: s.Peak(), Started: 3}, GetSchedulerStats(ctx))
+	})
+}
+
+func TestFieldSetDispatchWith(t *testing.T) {
+	fields := []CollectedField{
+		{Field: &ast.Field{Name: "a", Alias: "a"}},
+		{Field: &ast.Field{Name: "b", Alias: "b"}},
+		{Field: &ast.Field{Name: "c", Alias: "c"}},
+	}
+	s := NewScheduler(nil, nil, func(field CollectedField) bool {
+		return field.Name == "c"
+	})
+
+	set := NewFieldSet(fields)
+	for i, field := range fields {
+		value := field.Name
+		set.Concurrently(i, func() Marshaler {
+			return MarshalString(value)
+		})
+	}
+	set.DispatchWith(s)
+
+	require.Equal(t, 1, s.Started(), "a is resolved by the caller and c is inline")
+	require.Equal(t, `{"a":"a","b":"b","c":"c"}`, m2s(set))
+}
@@ -12,6 +12,9 @@ type Stats struct {
 	Parsing        TraceTiming
 	Validation     TraceTiming
 
+	// Batch is set when the operation was sent in a batch of operations
+	Batch *BatchStats
+
 	// Stats collected by handler extensions. Dont use directly, the extension should provide a type safe way to
 	// access this.
 	extension map[string]interface{}
@@ -22,6 +25,12 @@ type TraceTiming struct {
 	End   time.Time
 }
 
+// BatchStats describes the batch of operations an operation was sent in
+type BatchStats struct {
+	Index int // The position of the operation in the batch
+	Size  int // The number of operations in the batch
+}
+
 var ctxTraceStart key = "trace_start"
 
 // StartOperationTrace captures the current time and stores it in context. This will eventually be added to request
@@ -0,0 +1,72 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// TimeoutsExtension is the key of the response extension listing the paths of the fields that timed out.
+const TimeoutsExtension = "timeouts"
+
+// TimeoutError is the error of fields whose resolver didn't return before their timeout.
+type TimeoutError struct {
+	Timeout time.Duration
+}
+
+func (e *TimeoutError) Error() string {
+	return fmt.Sprintf("the field timed out after %s", e.Timeout)
+}
+
+// ResolveWithTimeout calls resolver with a context that is cancelled after timeout. When the resolver doesn't return
+// by then the field fails with a TimeoutError, without waiting for the resolver any longer, and its path is listed in
+// the timeouts extension of the response.
+func ResolveWithTimeout(ctx context.Context, timeout time.Duration, resolver Resolver) (interface{}, error) {
+	tctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	type result struct {
+		res       interface{}
+		err       error
+		recovered interface{}
+	}
+	done := make(chan result, 1)
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				done <- result{recovered: r}
+			}
+		}()
+		res, err := resolver(tctx)
+		done <- result{res: res, err: err}
+	}()
+
+	select {
+	case r := <-done:
+		if r.recovered != nil {
+			// panic in the goroutine of the field, so it is recovered like any other resolver panic
+			panic(r.recovered)
+		}
+		return r.res, r.err
+	case <-tctx.Done():
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		addTimeout(ctx, GetFieldContext(ctx).Path())
+		return nil, &TimeoutError{Timeout: timeout}
+	}
+}
+
+func addTimeout(ctx context.Context, path ast.Path) {
+	c := getResponseContext(ctx)
+	c.extensionsMu.Lock()
+	defer c.extensionsMu.Unlock()
+
+	if c.extensions == nil {
+		c.extensions = make(map[string]interface{})
+	}
+	paths, _ := c.extensions[TimeoutsExtension].([]ast.Path)
+	c.extensions[TimeoutsExtension] = append(paths, path)
+}
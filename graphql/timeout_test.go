@@ -0,0 +1,60 @@
+package graphql
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+func TestResolveWithTimeout(t *testing.T) {
+	newContext := func() context.Context {
+		ctx := WithResponseContext(context.Background(), DefaultErrorPresenter, DefaultRecover)
+		return WithFieldContext(ctx, &FieldContext{Field: CollectedField{Field: &ast.Field{Alias: "slow"}}})
+	}
+
+	t.Run("returns the result in time", func(t *testing.T) {
+		ctx := newContext()
+		res, err := ResolveWithTimeout(ctx, time.Second, func(ctx context.Context) (interface{}, error) {
+			_, ok := ctx.Deadline()
+			require.True(t, ok)
+			return "ok", nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, "ok", res)
+		require.Nil(t, GetExtension(ctx, TimeoutsExtension))
+	})
+
+	t.Run("times out", func(t *testing.T) {
+		ctx := newContext()
+		res, err := ResolveWithTimeout(ctx, time.Millisecond, func(ctx context.Context) (interface{}, error) {
+			<-ctx.Done()
+			return "late", nil
+		})
+		require.Nil(t, res)
+		require.Equal(t, &TimeoutError{Timeout: time.Millisecond}, err)
+		require.Equal(t, []ast.Path{{ast.PathName("slow")}}, GetExtension(ctx, TimeoutsExtension))
+	})
+
+	t.Run("does not report cancelled operations as timeouts", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(newContext())
+		cancel()
+		_, err := ResolveWithTimeout(ctx, time.Second, func(ctx context.Context) (interface{}, error) {
+			<-ctx.Done()
+			time.Sleep(10 * time.Millisecond)
+			return nil, nil
+		})
+		require.Equal(t, context.Canceled, err)
+		require.Nil(t, GetExtension(ctx, TimeoutsExtension))
+	})
+
+	t.Run("panics in the goroutine of the field", func(t *testing.T) {
+		require.PanicsWithValue(t, "boom", func() {
+			ResolveWithTimeout(newContext(), time.Second, func(ctx context.Context) (interface{}, error) {
+				panic("boom")
+			})
+		})
+	})
+}
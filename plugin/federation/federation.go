@@ -2,6 +2,7 @@ package federation
 
 import (
 	"fmt"
+	"go/types"
 	"sort"
 	"strings"
 
@@ -16,12 +17,27 @@ import (
 
 type federation struct {
 	Entities []*Entity
+	Version  int
+
+	// Link is added to the SDL of federation 2 schemas
+	// that do not link the federation specification themselves
+	Link string
 }
 
 // New returns a federation plugin that injects
 // federated directives and types into the schema
 func New() plugin.Plugin {
-	return &federation{}
+	return &federation{Version: 1}
+}
+
+// NewWithVersion returns a federation plugin for the given version
+// of the federation specification, 1 when version is 0
+func NewWithVersion(version int) plugin.Plugin {
+	if version == 0 {
+		version = 1
+	}
+
+	return &federation{Version: version}
 }
 
 // Name returns the plugin name
@@ -63,22 +79,61 @@ func (f *federation) MutateConfig(cfg *config.Config) error {
 	cfg.Directives["key"] = config.DirectiveConfig{SkipRuntime: true}
 	cfg.Directives["extends"] = config.DirectiveConfig{SkipRuntime: true}
 
+	if f.Version == 2 {
+		cfg.Models["link__Import"] = config.TypeMapEntry{
+			Model: config.StringList{"github.com/99designs/gqlgen/graphql.Any"},
+		}
+		cfg.Models["link__Purpose"] = config.TypeMapEntry{
+			Model: config.StringList{"github.com/99designs/gqlgen/graphql.String"},
+		}
+		for _, name := range []string{"link", "shareable", "inaccessible", "override", "tag", "interfaceObject", "composeDirective"} {
+			cfg.Directives[name] = config.DirectiveConfig{SkipRuntime: true}
+		}
+
+		if err := f.setLink(cfg); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
 func (f *federation) InjectSourceEarly() *ast.Source {
-	return &ast.Source{
-		Name: "federation/directives.graphql",
-		Input: `
+	input := `
 scalar _Any
 scalar _FieldSet
 
 directive @external on FIELD_DEFINITION
 directive @requires(fields: _FieldSet!) on FIELD_DEFINITION
 directive @provides(fields: _FieldSet!) on FIELD_DEFINITION
-directive @key(fields: _FieldSet!) repeatable on OBJECT | INTERFACE
 directive @extends on OBJECT | INTERFACE
-`,
+`
+	if f.Version == 2 {
+		input += `
+scalar link__Import
+enum link__Purpose {
+  SECURITY
+  EXECUTION
+}
+
+directive @key(fields: _FieldSet!, resolvable: Boolean = true) repeatable on OBJECT | INTERFACE
+directive @link(url: String!, as: String, import: [link__Import], for: link__Purpose) repeatable on SCHEMA
+directive @shareable repeatable on OBJECT | FIELD_DEFINITION
+directive @inaccessible on FIELD_DEFINITION | OBJECT | INTERFACE | UNION | ARGUMENT_DEFINITION | SCALAR | ENUM | ENUM_VALUE | INPUT_OBJECT | INPUT_FIELD_DEFINITION
+directive @override(from: String!) on FIELD_DEFINITION
+directive @tag(name: String!) repeatable on FIELD_DEFINITION | OBJECT | INTERFACE | UNION | ARGUMENT_DEFINITION | SCALAR | ENUM | ENUM_VALUE | INPUT_OBJECT | INPUT_FIELD_DEFINITION
+directive @interfaceObject on OBJECT
+directive @composeDirective(name: String!) repeatable on SCHEMA
+`
+	} else {
+		input += `
+directive @key(fields: _FieldSet!) repeatable on OBJECT | INTERFACE
+`
+	}
+
+	return &ast.Source{
+		Name:    "federation/directives.graphql",
+		Input:   input,
 		BuiltIn: true,
 	}
 }
@@ -91,11 +146,15 @@ func (f *federation) InjectSourceLate(schema *ast.Schema) *ast.Source {
 	entities := ""
 	resolvers := ""
 	entityResolverInputDefinitions := ""
-	for i, e := range f.Entities {
-		if i != 0 {
-			entities += " | "
+	for _, e := range f.Entities {
+		// unions can only have object members, interfaces are
+		// resolved to one of their implementations which are entities too
+		if !e.Interface {
+			if entities != "" {
+				entities += " | "
+			}
+			entities += e.Name
 		}
-		entities += e.Name
 
 		for _, r := range e.Resolvers {
 			if e.Multi {
@@ -159,6 +218,7 @@ type Entity struct {
 	Resolvers []*EntityResolver
 	Requires  []*Requires
 	Multi     bool
+	Interface bool // The entity is an interface, and its resolvers return one of its implementations
 }
 
 type EntityResolver struct {
@@ -175,9 +235,18 @@ type KeyField struct {
 
 // Requires represents an @requires clause
 type Requires struct {
-	Name  string                // the name of the field
-	Field fieldset.Field        // source Field, len > 1 for nested fields
-	Type  *config.TypeReference // The Go representation of that field type
+	Name   string                // the name of the field
+	Field  fieldset.Field        // source Field, len > 1 for nested fields
+	Type   *config.TypeReference // The Go representation of that field type
+	Target string                // The Go struct field the value is decoded to, such as Host.ID
+	Allocs []*RequiresAlloc      // The nil pointers to allocate before decoding nested fields
+}
+
+// RequiresAlloc is a pointer to a struct that must be allocated
+// before decoding a nested @requires field into it
+type RequiresAlloc struct {
+	Target string     // The Go struct field holding the pointer, such as Host
+	Type   types.Type // The type of the struct it points to
 }
 
 func (e *Entity) allFieldsAreExternal() bool {
@@ -196,6 +265,11 @@ func (f *federation) GenerateCode(data *codegen.Data) error {
 		}
 		for _, e := range f.Entities {
 			obj := data.Objects.ByName(e.Def.Name)
+			if e.Interface {
+				// interfaces have no fields of their own in codegen, but
+				// their implementations have the same key fields
+				obj = data.Objects.ByName(data.Schema.GetPossibleTypes(e.Def)[0].Name)
+			}
 
 			for _, r := range e.Resolvers {
 				// fill in types for key fields
@@ -221,6 +295,9 @@ func (f *federation) GenerateCode(data *codegen.Data) error {
 				}
 				cgField := reqField.Field.TypeReference(obj, data.Objects)
 				reqField.Type = cgField.TypeReference
+				if err := reqField.setTarget(obj, data.Objects); err != nil {
+					return err
+				}
 			}
 		}
 	}
@@ -234,6 +311,33 @@ func (f *federation) GenerateCode(data *codegen.Data) error {
 	})
 }
 
+// setTarget finds the fields of the Go model the @requires field is decoded to
+func (r *Requires) setTarget(obj *codegen.Object, objects codegen.Objects) error {
+	var names []string
+	for i := range r.Field {
+		field := r.Field[:i+1].TypeReference(obj, objects)
+		if field.GoFieldType != codegen.GoFieldVariable {
+			return fmt.Errorf("@requires field %s of %s must be a field of the Go model", r.Field.Join("."), obj.Name)
+		}
+		names = append(names, field.GoFieldName)
+
+		if i == r.Field.LastIndex() {
+			break
+		}
+		if field.TypeReference.IsSlice() {
+			return fmt.Errorf("@requires field %s of %s can not be nested in a list", r.Field.Join("."), obj.Name)
+		}
+		if ptr, ok := field.TypeReference.GO.(*types.Pointer); ok {
+			r.Allocs = append(r.Allocs, &RequiresAlloc{
+				Target: strings.Join(names, "."),
+				Type:   ptr.Elem(),
+			})
+		}
+	}
+	r.Target = strings.Join(names, ".")
+	return nil
+}
+
 func (f *federation) setEntities(schema *ast.Schema) {
 	for _, schemaType := range schema.Types {
 		keys, ok := isFederatedEntity(schemaType)
@@ -245,6 +349,7 @@ func (f *federation) setEntities(schema *ast.Schema) {
 			Def:       schemaType,
 			Resolvers: nil,
 			Requires:  nil,
+			Interface: schemaType.Kind == ast.Interface,
 		}
 
 		// Let's process custom entity resolver settings.
@@ -277,10 +382,15 @@ func (f *federation) setEntities(schema *ast.Schema) {
 		//    }
 		if !e.allFieldsAreExternal() {
 			for _, dir := range keys {
-				if len(dir.Arguments) != 1 || dir.Arguments[0].Name != "fields" {
+				arg := dir.Arguments.ForName("fields")
+				if arg == nil || len(dir.Arguments) > 2 || (len(dir.Arguments) == 2 && dir.Arguments.ForName("resolvable") == nil) {
 					panic("Exactly one `fields` argument needed for @key declaration.")
 				}
-				arg := dir.Arguments[0]
+				// keys that are not resolvable only reference entities
+				// resolved by other services, so they don't need a resolver
+				if resolvable := dir.Arguments.ForName("resolvable"); resolvable != nil && resolvable.Value.Raw == "false" {
+					continue
+				}
 				keyFieldSet := fieldset.New(arg.Value.Raw, nil)
 
 				keyFields := make([]*KeyField, len(keyFieldSet))
@@ -321,6 +431,9 @@ func (f *federation) setEntities(schema *ast.Schema) {
 				if len(dir.Arguments) != 1 || dir.Arguments[0].Name != "fields" {
 					panic("Exactly one `fields` argument needed for @requires declaration.")
 				}
+				if e.Interface {
+					panic(fmt.Sprintf("@requires is not supported on fields of the interface %s.", e.Name))
+				}
 				requiresFieldSet := fieldset.New(dir.Arguments[0].Value.Raw, nil)
 				for _, field := range requiresFieldSet {
 					e.Requires = append(e.Requires, &Requires{
@@ -337,22 +450,35 @@ func (f *federation) setEntities(schema *ast.Schema) {
 	sort.Slice(f.Entities, func(i, j int) bool {
 		return f.Entities[i].Name < f.Entities[j].Name
 	})
+
+	// the implementations of an entity interface are resolved by the
+	// resolvers of the interface, so they must be entities as well
+	for _, e := range f.Entities {
+		if !e.Interface {
+			continue
+		}
+		if len(schema.GetPossibleTypes(e.Def)) == 0 {
+			panic(fmt.Sprintf("The entity interface %s needs at least one implementation.", e.Name))
+		}
+		for _, impl := range schema.GetPossibleTypes(e.Def) {
+			if _, ok := isFederatedEntity(impl); !ok {
+				panic(fmt.Sprintf("%s implements the entity interface %s, so it needs a @key too.", impl.Name, e.Name))
+			}
+		}
+	}
 }
 
 func isFederatedEntity(schemaType *ast.Definition) ([]*ast.Directive, bool) {
 	switch schemaType.Kind {
-	case ast.Object:
+	case ast.Object, ast.Interface:
 		keys := schemaType.Directives.ForNames("key")
 		if len(keys) > 0 {
 			return keys, true
 		}
-	case ast.Interface:
-		// TODO: support @key and @extends for interfaces
-		if dir := schemaType.Directives.ForName("key"); dir != nil {
-			panic("@key directive is not currently supported for interfaces.")
-		}
-		if dir := schemaType.Directives.ForName("extends"); dir != nil {
-			panic("@extends directive is not currently supported for interfaces.")
+		// an @interfaceObject stands in for an entity interface of another
+		// service, so the gateway needs a key to resolve it here
+		if schemaType.Directives.ForName("interfaceObject") != nil {
+			panic(fmt.Sprintf("@interfaceObject type %s needs a @key.", schemaType.Name))
 		}
 	default:
 		// ignore
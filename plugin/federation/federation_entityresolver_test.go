@@ -311,6 +311,131 @@ func TestEntityResolver(t *testing.T) {
 		require.Equal(t, resp.Entities[1].Name, "mars")
 		require.Equal(t, resp.Entities[1].World.Foo, "B")
 	})
+
+	t.Run("PlanetRequires entities with invalid requires fields", func(t *testing.T) {
+		representations := []map[string]interface{}{
+			{
+				"__typename": "PlanetRequires",
+				"name":       "earth",
+				"diameter":   12,
+			}, {
+				"__typename": "PlanetRequires",
+				"name":       "mars",
+			}, {
+				"__typename": "PlanetRequiresNested",
+				"name":       "earth",
+				"world":      "A",
+			},
+		}
+
+		var resp struct {
+			Entities []struct {
+				Name     string `json:"name"`
+				Diameter int    `json:"diameter"`
+			} `json:"_entities"`
+		}
+
+		err := c.Post(
+			entityQuery([]string{
+				"PlanetRequires {name, diameter}",
+				"PlanetRequiresNested {name}",
+			}),
+			&resp,
+			client.Var("representations", representations),
+		)
+
+		require.Error(t, err)
+		entityErrors, err := getEntityErrors(err)
+		require.NoError(t, err)
+		require.ElementsMatch(t, []*entityResolverError{
+			{
+				Message: "representation 1: invalid @requires field diameter: diameter is missing from the representation",
+				Path:    []interface{}{"_entities", float64(1)},
+			},
+			{
+				Message: "representation 2: invalid @requires field world.foo: world must be an object",
+				Path:    []interface{}{"_entities", float64(2)},
+			},
+		}, entityErrors)
+
+		require.Len(t, resp.Entities, 3)
+		require.Equal(t, resp.Entities[0].Name, "earth")
+		require.Equal(t, resp.Entities[0].Diameter, 12)
+		require.Equal(t, resp.Entities[1].Name, "")
+		require.Equal(t, resp.Entities[2].Name, "")
+	})
+
+	t.Run("Media entities resolved by their interface", func(t *testing.T) {
+		representations := []map[string]interface{}{
+			{
+				"__typename": "Media",
+				"id":         "book-1",
+			}, {
+				"__typename": "Media",
+				"id":         "movie-1",
+			}, {
+				"__typename": "Book",
+				"id":         "book-2",
+			},
+		}
+
+		var resp struct {
+			Entities []struct {
+				Typename string `json:"__typename"`
+				ID       string `json:"id"`
+				Title    string `json:"title"`
+			} `json:"_entities"`
+		}
+
+		err := c.Post(
+			entityQuery([]string{
+				"Media {__typename, id, title}",
+				"Book {__typename, id}",
+			}),
+			&resp,
+			client.Var("representations", representations),
+		)
+
+		require.NoError(t, err)
+		require.Len(t, resp.Entities, 3)
+		require.Equal(t, "Book", resp.Entities[0].Typename)
+		require.Equal(t, "book-1", resp.Entities[0].ID)
+		require.Equal(t, "Book book-1", resp.Entities[0].Title)
+		require.Equal(t, "Movie", resp.Entities[1].Typename)
+		require.Equal(t, "movie-1", resp.Entities[1].ID)
+		require.Equal(t, "Movie movie-1", resp.Entities[1].Title)
+		require.Equal(t, "Book", resp.Entities[2].Typename)
+		require.Equal(t, "book-2", resp.Entities[2].ID)
+	})
+
+	t.Run("Media entities with an unknown implementation", func(t *testing.T) {
+		representations := []map[string]interface{}{
+			{
+				"__typename": "Media",
+				"id":         "podcast-1",
+			},
+		}
+
+		var resp struct {
+			Entities []struct {
+				ID string `json:"id"`
+			} `json:"_entities"`
+		}
+
+		err := c.Post(
+			entityQuery([]string{
+				"Media {id}",
+			}),
+			&resp,
+			client.Var("representations", representations),
+		)
+
+		require.Error(t, err)
+		entityErrors, err := getEntityErrors(err)
+		require.NoError(t, err)
+		require.Len(t, entityErrors, 1)
+		require.Equal(t, `resolving Entity "Media": unknown media: podcast-1`, entityErrors[0].Message)
+	})
 }
 
 func TestMultiEntityResolver(t *testing.T) {
@@ -429,6 +554,79 @@ func TestMultiEntityResolver(t *testing.T) {
 		require.Len(t, entityErrors, 1)
 		require.Contains(t, entityErrors[0].Message, "error resolving MultiHelloWorldWithError")
 	})
+
+	t.Run("MultiPlanetRequiresNested entities", func(t *testing.T) {
+		representations := []map[string]interface{}{
+			{
+				"__typename": "MultiPlanetRequiresNested",
+				"name":       "earth",
+				"world": map[string]interface{}{
+					"foo": "A",
+				},
+			}, {
+				"__typename": "MultiPlanetRequiresNested",
+				"name":       "mars",
+				"world": map[string]interface{}{
+					"foo": "B",
+				},
+			},
+		}
+
+		var resp struct {
+			Entities []struct {
+				Name  string `json:"name"`
+				World struct {
+					Foo string `json:"foo"`
+				} `json:"world"`
+			} `json:"_entities"`
+		}
+
+		err := c.Post(
+			entityQuery([]string{
+				"MultiPlanetRequiresNested {name, world { foo }}",
+			}),
+			&resp,
+			client.Var("representations", representations),
+		)
+
+		require.NoError(t, err)
+		require.Equal(t, resp.Entities[0].Name, "earth")
+		require.Equal(t, resp.Entities[0].World.Foo, "A")
+		require.Equal(t, resp.Entities[1].Name, "mars")
+		require.Equal(t, resp.Entities[1].World.Foo, "B")
+	})
+
+	t.Run("MultiPlanetRequiresNested entities with a missing requires field", func(t *testing.T) {
+		representations := []map[string]interface{}{
+			{
+				"__typename": "MultiPlanetRequiresNested",
+				"name":       "earth",
+				"world":      map[string]interface{}{},
+			},
+		}
+
+		var resp struct {
+			Entities []struct {
+				Name string `json:"name"`
+			} `json:"_entities"`
+		}
+
+		err := c.Post(
+			entityQuery([]string{
+				"MultiPlanetRequiresNested {name}",
+			}),
+			&resp,
+			client.Var("representations", representations),
+		)
+
+		require.Error(t, err)
+		entityErrors, err := getEntityErrors(err)
+		require.NoError(t, err)
+		require.Equal(t, []*entityResolverError{{
+			Message: "representation 0: invalid @requires field world.foo: world.foo is missing from the representation",
+			Path:    []interface{}{"_entities", float64(0)},
+		}}, entityErrors)
+	})
 }
 
 func entityQuery(queries []string) string {
@@ -443,8 +641,8 @@ func entityQuery(queries []string) string {
 }
 
 type entityResolverError struct {
-	Message string   `json:"message"`
-	Path    []string `json:"path"`
+	Message string        `json:"message"`
+	Path    []interface{} `json:"path"`
 }
 
 func getEntityErrors(err error) ([]*entityResolverError, error) {
@@ -6,6 +6,7 @@ import (
 	"github.com/99designs/gqlgen/codegen"
 	"github.com/99designs/gqlgen/codegen/config"
 	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
 )
 
 func TestWithEntities(t *testing.T) {
@@ -88,8 +89,28 @@ func TestNoEntities(t *testing.T) {
 }
 
 func TestInterfaces(t *testing.T) {
-	require.Panics(t, func() {
-		load(t, "testdata/interfaces/gqlgen.yml")
+	f, cfg := load(t, "testdata/interfaces/gqlgen.yml")
+
+	// interfaces are resolved to their implementations, so they are not part of the union
+	require.Equal(t, []string{"HelloWorld"}, cfg.Schema.Types["_Entity"].Types)
+
+	require.Len(t, f.Entities, 2)
+	require.Equal(t, "Hello", f.Entities[0].Name)
+	require.True(t, f.Entities[0].Interface)
+	require.Len(t, f.Entities[0].Resolvers, 1)
+	require.Equal(t, "findHelloByName", f.Entities[0].Resolvers[0].ResolverName)
+	require.Equal(t, "Hello", cfg.Schema.Types["Entity"].Fields.ForName("findHelloByName").Type.Name())
+
+	require.Equal(t, "HelloWorld", f.Entities[1].Name)
+	require.False(t, f.Entities[1].Interface)
+
+	require.NoError(t, f.MutateConfig(cfg))
+	data, err := codegen.BuildData(cfg)
+	require.NoError(t, err)
+	require.NoError(t, f.GenerateCode(data))
+
+	require.PanicsWithValue(t, "HelloWorld implements the entity interface Hello, so it needs a @key too.", func() {
+		load(t, "testdata/interfaces/unkeyed.yml")
 	})
 }
 
@@ -106,6 +127,74 @@ func TestCodeGeneration(t *testing.T) {
 		panic(err)
 	}
 	require.NoError(t, f.GenerateCode(data))
+
+	require.Equal(t, "ID", f.Entities[4].Requires[0].Target)
+	require.Empty(t, f.Entities[4].Requires[0].Allocs)
+	require.Equal(t, "Hello.Secondary", f.Entities[4].Requires[1].Target)
+	require.Len(t, f.Entities[4].Requires[1].Allocs, 1)
+	require.Equal(t, "Hello", f.Entities[4].Requires[1].Allocs[0].Target)
+}
+
+func TestFederation2(t *testing.T) {
+	f, cfg := load(t, "testdata/federation2/gqlgen.yml")
+
+	require.Equal(t, []string{"Inventory", "Product", "Review"}, cfg.Schema.Types["_Entity"].Types)
+
+	require.NoError(t, f.MutateConfig(cfg))
+
+	require.Len(t, f.Entities, 3)
+
+	// @interfaceObject types are resolved like objects
+	require.Equal(t, "Inventory", f.Entities[0].Name)
+	require.False(t, f.Entities[0].Interface)
+	require.Len(t, f.Entities[0].Resolvers, 1)
+	require.Len(t, f.Entities[0].Requires, 1)
+	require.PanicsWithValue(t, "@interfaceObject type Inventory needs a @key.", func() {
+		isFederatedEntity(&ast.Definition{Kind: ast.Object, Name: "Inventory", Directives: ast.DirectiveList{{Name: "interfaceObject"}}})
+	})
+
+	// keys that are not resolvable don't need a resolver
+	require.Equal(t, "Product", f.Entities[1].Name)
+	require.Len(t, f.Entities[1].Resolvers, 1)
+	require.Equal(t, "findProductByUpc", f.Entities[1].Resolvers[0].ResolverName)
+
+	require.Equal(t, "Review", f.Entities[2].Name)
+	require.Len(t, f.Entities[2].Resolvers, 0)
+
+	require.True(t, cfg.Directives["shareable"].SkipRuntime)
+	require.True(t, cfg.Directives["override"].SkipRuntime)
+
+	// the schema doesn't link the federation specification, so _service adds the link
+	require.Equal(t, `extend schema @link(url: "https://specs.apollo.dev/federation/v2.3", import: ["@composeDirective", "@extends", "@external", "@inaccessible", "@interfaceObject", "@key", "@override", "@provides", "@requires", "@shareable", "@tag", "FieldSet"])`, f.Link)
+
+	data, err := codegen.BuildData(cfg)
+	require.NoError(t, err)
+	require.NoError(t, f.GenerateCode(data))
+}
+
+func TestFederation2Link(t *testing.T) {
+	f, cfg := load(t, "testdata/federation2/gqlgen.yml")
+	sources := cfg.Sources
+
+	setLink := func(input string) error {
+		cfg.Sources = append(sources[:len(sources):len(sources)], &ast.Source{Name: "link.graphql", Input: input})
+		f.Link = ""
+		return f.setLink(cfg)
+	}
+
+	require.NoError(t, setLink(`extend schema @link(url: "https://specs.apollo.dev/federation/v2.0", import: ["@key", "@shareable", {name: "@override"}, "@tag", "@inaccessible", "@interfaceObject", "@external", "@requires"])`))
+	require.Empty(t, f.Link)
+
+	require.EqualError(t, setLink(`extend schema @link(url: "https://specs.apollo.dev/federation/v2.0", import: ["@key", "@external", "@requires"])`),
+		"@inaccessible, @interfaceObject, @override, @shareable, @tag must be imported by the @link to the federation specification")
+	require.EqualError(t, setLink(`extend schema @link(url: "https://specs.apollo.dev/federation/v1.0")`),
+		"federation version 2 needs a @link to a v2 federation specification, got https://specs.apollo.dev/federation/v1.0")
+	require.EqualError(t, setLink(`extend schema @link(url: "https://specs.apollo.dev/federation/v2.3", import: [{name: "@key", as: "@id"}])`),
+		"renaming @key to @id in @link imports is not supported")
+	require.EqualError(t, setLink(`extend schema @link(url: "https://specs.apollo.dev/federation/v2.3", import: ["@unknown"])`),
+		"@unknown is not part of the federation specification")
+	require.EqualError(t, setLink(`extend schema @composeDirective(name: "@custom")`),
+		`@composeDirective must name a directive defined in the schema, like "@custom"`)
 }
 
 func load(t *testing.T, name string) (*federation, *config.Config) {
@@ -114,7 +203,7 @@ func load(t *testing.T, name string) (*federation, *config.Config) {
 	cfg, err := config.LoadConfig(name)
 	require.NoError(t, err)
 
-	f := &federation{}
+	f := NewWithVersion(cfg.Federation.Version).(*federation)
 	cfg.Sources = append(cfg.Sources, f.InjectSourceEarly())
 	require.NoError(t, cfg.LoadSchema())
 
@@ -1,5 +1,16 @@
 package fedruntime
 
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
 // Service is the service object that the
 // generated.go file will return for the _service
 // query
@@ -11,3 +22,30 @@ type Service struct {
 type Entity interface {
 	IsEntity()
 }
+
+// RequiredField looks up a field required by @requires in an entity representation,
+// following the path of nested selections.
+func RequiredField(rep map[string]interface{}, path ...string) (interface{}, error) {
+	obj := rep
+	for i, name := range path {
+		value, ok := obj[name]
+		if !ok {
+			return nil, fmt.Errorf("%s is missing from the representation", strings.Join(path[:i+1], "."))
+		}
+		if i == len(path)-1 {
+			return value, nil
+		}
+		if obj, ok = value.(map[string]interface{}); !ok {
+			return nil, fmt.Errorf("%s must be an object", strings.Join(path[:i+1], "."))
+		}
+	}
+	return nil, fmt.Errorf("no field given")
+}
+
+// RequiresError reports a field required by @requires that could not be decoded,
+// pointing at the representation it comes from.
+func RequiresError(ctx context.Context, index int, field string, err error) *gqlerror.Error {
+	path := append(ast.Path{}, graphql.GetPath(ctx)...)
+	path = append(path, ast.PathIndex(index))
+	return gqlerror.ErrorPathf(path, "representation %d: invalid @requires field %s: %s", index, field, err.Error())
+}
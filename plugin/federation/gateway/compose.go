@@ -0,0 +1,286 @@
+package gateway
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/formatter"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+// compose merges the types of every subgraph into the supergraph schema. Types with the same name are merged into
+// one, and must be of the same kind, while fields with the same name must have the same type. The elements marked
+// with @inaccessible are removed from the supergraph, and so are all the directives but @deprecated.
+func (g *Gateway) compose() error {
+	g.owners = map[string]map[string][]*subgraph{}
+
+	var types ast.DefinitionList
+	inaccessible := map[string]bool{}
+
+	for _, sg := range g.subgraphs {
+		doc, err := parser.ParseSchema(&ast.Source{Name: sg.name, Input: sg.sdl})
+		if err != nil {
+			return fmt.Errorf("parsing the sdl of %s: %w", sg.name, err)
+		}
+
+		sg.types = map[string]*ast.Definition{}
+		sg.keys = map[string][]string{}
+		sg.allKeys = map[string][]string{}
+		sg.requires = map[string]map[string]string{}
+		sg.interfaceObjects = map[string]bool{}
+
+		for _, def := range append(doc.Definitions, doc.Extensions...) {
+			if strings.HasPrefix(def.Name, "_") || strings.HasPrefix(def.Name, "link__") || strings.HasPrefix(def.Name, "federation__") {
+				continue
+			}
+
+			kind := def.Kind
+			if def.Directives.ForName("interfaceObject") != nil {
+				kind = ast.Interface
+				sg.interfaceObjects[def.Name] = true
+			}
+
+			sg.addType(def)
+
+			merged := types.ForName(def.Name)
+			if merged == nil {
+				merged = &ast.Definition{
+					Kind:        kind,
+					Description: def.Description,
+					Name:        def.Name,
+					Directives:  supergraphDirectives(def.Directives),
+				}
+				types = append(types, merged)
+			}
+			if merged.Kind != kind {
+				return fmt.Errorf("%s is %s in %s and %s in another subgraph", def.Name, kindName(kind), sg.name, kindName(merged.Kind))
+			}
+			if merged.Description == "" {
+				merged.Description = def.Description
+			}
+			if def.Directives.ForName("inaccessible") != nil {
+				inaccessible[def.Name] = true
+			}
+			if !sg.interfaceObjects[def.Name] {
+				merged.Interfaces = union(merged.Interfaces, def.Interfaces)
+			}
+			merged.Types = union(merged.Types, def.Types)
+
+			for _, value := range def.EnumValues {
+				if value.Directives.ForName("inaccessible") != nil {
+					inaccessible[def.Name+"."+value.Name] = true
+				}
+				if merged.EnumValues.ForName(value.Name) == nil {
+					merged.EnumValues = append(merged.EnumValues, &ast.EnumValueDefinition{
+						Description: value.Description,
+						Name:        value.Name,
+						Directives:  supergraphDirectives(value.Directives),
+					})
+				}
+			}
+
+			for _, field := range def.Fields {
+				if field.Directives.ForName("inaccessible") != nil {
+					inaccessible[def.Name+"."+field.Name] = true
+				}
+
+				if f := merged.Fields.ForName(field.Name); f != nil {
+					if f.Type.String() != field.Type.String() {
+						return fmt.Errorf("%s.%s has type %s in %s and %s in another subgraph", def.Name, field.Name, field.Type.String(), sg.name, f.Type.String())
+					}
+				} else {
+					merged.Fields = append(merged.Fields, supergraphField(field))
+				}
+
+				if field.Directives.ForName("external") == nil {
+					g.addOwner(def.Name, field.Name, sg)
+				}
+			}
+		}
+	}
+
+	var visible ast.DefinitionList
+	for _, def := range types {
+		if inaccessible[def.Name] {
+			continue
+		}
+		var fields ast.FieldList
+		for _, field := range def.Fields {
+			if !inaccessible[def.Name+"."+field.Name] && !inaccessible[field.Type.Name()] {
+				fields = append(fields, field)
+			}
+		}
+		def.Fields = fields
+
+		var values ast.EnumValueList
+		for _, value := range def.EnumValues {
+			if !inaccessible[def.Name+"."+value.Name] {
+				values = append(values, value)
+			}
+		}
+		def.EnumValues = values
+
+		var members []string
+		for _, member := range def.Types {
+			if !inaccessible[member] {
+				members = append(members, member)
+			}
+		}
+		def.Types = members
+		visible = append(visible, def)
+	}
+
+	// make sure the supergraph remains stable whatever the order of the subgraphs
+	sort.SliceStable(visible, func(i, j int) bool {
+		return visible[i].Name < visible[j].Name
+	})
+
+	var buf bytes.Buffer
+	formatter.NewFormatter(&buf).FormatSchemaDocument(&ast.SchemaDocument{Definitions: visible})
+	g.sdl = buf.String()
+
+	schema, err := gqlparser.LoadSchema(&ast.Source{Name: "supergraph.graphql", Input: g.sdl})
+	if err != nil {
+		return fmt.Errorf("composing the supergraph: %w", err)
+	}
+	g.schema = schema
+	return nil
+}
+
+// addType records the definition of a type in the subgraph, along with its keys and required fields
+func (sg *subgraph) addType(def *ast.Definition) {
+	if existing, ok := sg.types[def.Name]; ok {
+		merged := *existing
+		merged.Fields = append(merged.Fields[:len(merged.Fields):len(merged.Fields)], def.Fields...)
+		sg.types[def.Name] = &merged
+	} else {
+		sg.types[def.Name] = def
+	}
+
+	for _, key := range def.Directives.ForNames("key") {
+		fields := key.Arguments.ForName("fields")
+		if fields == nil {
+			continue
+		}
+		sg.allKeys[def.Name] = append(sg.allKeys[def.Name], fields.Value.Raw)
+		if resolvable := key.Arguments.ForName("resolvable"); resolvable != nil && resolvable.Value.Raw == "false" {
+			continue
+		}
+		sg.keys[def.Name] = append(sg.keys[def.Name], fields.Value.Raw)
+	}
+
+	for _, field := range def.Fields {
+		if requires := field.Directives.ForName("requires"); requires != nil {
+			if fields := requires.Arguments.ForName("fields"); fields != nil {
+				if sg.requires[def.Name] == nil {
+					sg.requires[def.Name] = map[string]string{}
+				}
+				sg.requires[def.Name][field.Name] = fields.Value.Raw
+			}
+		}
+	}
+}
+
+func (g *Gateway) addOwner(typeName, fieldName string, sg *subgraph) {
+	if g.owners[typeName] == nil {
+		g.owners[typeName] = map[string][]*subgraph{}
+	}
+	for _, owner := range g.owners[typeName][fieldName] {
+		if owner == sg {
+			return
+		}
+	}
+	g.owners[typeName][fieldName] = append(g.owners[typeName][fieldName], sg)
+}
+
+// resolves returns whether the subgraph can return a field of a type, either because it resolves it or because
+// the field is part of one of its keys.
+func (g *Gateway) resolves(sg *subgraph, typeName, fieldName string) bool {
+	for _, owner := range g.owners[typeName][fieldName] {
+		if owner == sg {
+			return true
+		}
+	}
+	for _, key := range sg.allKeys[typeName] {
+		for _, sel := range parseFieldSet(key) {
+			if field, ok := sel.(*ast.Field); ok && field.Name == fieldName {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// parseFieldSet parses the fields argument of @key and @requires, which has the syntax of a selection set without
+// its braces
+func parseFieldSet(raw string) ast.SelectionSet {
+	doc, err := parser.ParseQuery(&ast.Source{Input: "{" + raw + "}"})
+	if err != nil || len(doc.Operations) == 0 {
+		return nil
+	}
+	return doc.Operations[0].SelectionSet
+}
+
+// supergraphField copies a field without the directives of the subgraph
+func supergraphField(field *ast.FieldDefinition) *ast.FieldDefinition {
+	f := &ast.FieldDefinition{
+		Description:  field.Description,
+		Name:         field.Name,
+		DefaultValue: field.DefaultValue,
+		Type:         field.Type,
+		Directives:   supergraphDirectives(field.Directives),
+	}
+	for _, arg := range field.Arguments {
+		f.Arguments = append(f.Arguments, &ast.ArgumentDefinition{
+			Description:  arg.Description,
+			Name:         arg.Name,
+			DefaultValue: arg.DefaultValue,
+			Type:         arg.Type,
+			Directives:   supergraphDirectives(arg.Directives),
+		})
+	}
+	return f
+}
+
+// supergraphDirectives removes the directives that are specific to the subgraphs
+func supergraphDirectives(list ast.DirectiveList) ast.DirectiveList {
+	var directives ast.DirectiveList
+	for _, dir := range list {
+		if dir.Name == "deprecated" || dir.Name == "specifiedBy" {
+			directives = append(directives, dir)
+		}
+	}
+	return directives
+}
+
+func union(a, b []string) []string {
+outer:
+	for _, s := range b {
+		for _, existing := range a {
+			if existing == s {
+				continue outer
+			}
+		}
+		a = append(a, s)
+	}
+	return a
+}
+
+func kindName(kind ast.DefinitionKind) string {
+	switch kind {
+	case ast.Object:
+		return "an object"
+	case ast.Interface:
+		return "an interface"
+	case ast.InputObject:
+		return "an input object"
+	case ast.Enum:
+		return "an enum"
+	default:
+		return "a " + strings.ToLower(string(kind))
+	}
+}
@@ -0,0 +1,637 @@
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/formatter"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"github.com/vektah/gqlparser/v2/validator"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+type (
+	// execution runs an operation: the root fields are fetched from the subgraphs resolving them, and the fields
+	// those subgraphs can't resolve are fetched from the other subgraphs with _entities, using the keys of the
+	// entities they returned.
+	execution struct {
+		gateway *Gateway
+		rc      *graphql.OperationContext
+		data    map[string]interface{}
+		errors  gqlerror.List
+		fetches []*fetch
+	}
+
+	// fetch resolves fields of the entities found at a path of the response with a subgraph
+	fetch struct {
+		subgraph *subgraph
+
+		// path is made of the response names leading to the entities, lists are traversed
+		path []string
+
+		// typeName is the type of the entities to resolve
+		typeName string
+
+		// representation is the __typename of the representations, the name of an interface for @interfaceObject
+		representation string
+
+		// fields are the field sets copied from the entities into their representations
+		fields []string
+
+		selections ast.SelectionSet
+	}
+)
+
+// Execute runs an operation against the subgraphs. Subscriptions are not supported.
+func (g *Gateway) Execute(ctx context.Context, params *graphql.RawParams) *graphql.Response {
+	doc, errs := gqlparser.LoadQuery(g.schema, params.Query)
+	if len(errs) > 0 {
+		return &graphql.Response{Errors: errs}
+	}
+
+	op := doc.Operations.ForName(params.OperationName)
+	if op == nil {
+		return &graphql.Response{Errors: gqlerror.List{gqlerror.Errorf("operation %s not found", params.OperationName)}}
+	}
+
+	vars, err := validator.VariableValues(g.schema, op, params.Variables)
+	if err != nil {
+		return &graphql.Response{Errors: gqlerror.List{err}}
+	}
+
+	var root *ast.Definition
+	switch op.Operation {
+	case ast.Query:
+		root = g.schema.Query
+	case ast.Mutation:
+		root = g.schema.Mutation
+	default:
+		return &graphql.Response{Errors: gqlerror.List{gqlerror.Errorf("the gateway does not support %s operations", op.Operation)}}
+	}
+
+	e := &execution{
+		gateway: g,
+		rc: &graphql.OperationContext{
+			RawQuery:      params.Query,
+			Variables:     vars,
+			OperationName: params.OperationName,
+			Doc:           doc,
+			Operation:     op,
+		},
+		data: map[string]interface{}{},
+	}
+
+	e.executeRoot(ctx, root)
+	for i := 0; i < len(e.fetches); i++ {
+		e.executeFetch(ctx, e.fetches[i])
+	}
+
+	var data interface{}
+	if result := e.completeObject(root, op.SelectionSet, e.data); result != nil {
+		data = result
+	}
+	b, jsonErr := json.Marshal(data)
+	if jsonErr != nil {
+		e.errors = append(e.errors, gqlerror.Errorf("unable to encode the response: %s", jsonErr.Error()))
+	}
+	return &graphql.Response{Data: b, Errors: e.errors}
+}
+
+// executeRoot fetches the root fields, grouping them by the subgraph resolving them. The groups of mutations are
+// executed in the order of the fields.
+func (e *execution) executeRoot(ctx context.Context, root *ast.Definition) {
+	type group struct {
+		subgraph   *subgraph
+		selections ast.SelectionSet
+	}
+	var groups []*group
+
+	for _, field := range graphql.CollectFields(e.rc, e.rc.Operation.SelectionSet, []string{root.Name}) {
+		if strings.HasPrefix(field.Name, "__") {
+			continue
+		}
+		owners := e.gateway.owners[root.Name][field.Name]
+		if len(owners) == 0 {
+			e.errors = append(e.errors, &gqlerror.Error{
+				Message: "no subgraph resolves " + root.Name + "." + field.Name,
+				Path:    ast.Path{ast.PathName(field.Alias)},
+			})
+			continue
+		}
+
+		sel := *field.Field
+		sel.SelectionSet = field.Selections
+
+		var g *group
+		if e.rc.Operation.Operation == ast.Query {
+			// queries are fetched all at once, so every subgraph only needs one group
+			for _, existing := range groups {
+				if existing.subgraph == owners[0] {
+					g = existing
+				}
+			}
+		} else if len(groups) > 0 && groups[len(groups)-1].subgraph == owners[0] {
+			g = groups[len(groups)-1]
+		}
+		if g == nil {
+			g = &group{subgraph: owners[0]}
+			groups = append(groups, g)
+		}
+		g.selections = append(g.selections, &sel)
+	}
+
+	for _, g := range groups {
+		selections := e.plan(g.subgraph, root, g.selections, nil)
+		query, vars := e.operation(e.rc.Operation.Operation, nil, selections)
+		resp := e.do(ctx, g.subgraph, query, vars)
+		if resp == nil {
+			continue
+		}
+		e.errors = append(e.errors, resp.Errors...)
+		for k, v := range resp.Data {
+			e.data[k] = v
+		}
+	}
+}
+
+// executeFetch resolves the entities of a fetch, and merges the fields returned by the subgraph into them
+func (e *execution) executeFetch(ctx context.Context, f *fetch) {
+	var objects []map[string]interface{}
+	var paths []ast.Path
+	collectEntities(e.data, f.path, nil, f.typeName, &objects, &paths)
+	if len(objects) == 0 {
+		return
+	}
+
+	representations := make([]interface{}, len(objects))
+	for i, obj := range objects {
+		rep := map[string]interface{}{"__typename": f.representation}
+		for _, fields := range f.fields {
+			copyFieldSet(rep, obj, parseFieldSet(fields))
+		}
+		representations[i] = rep
+	}
+
+	entities := &ast.Field{
+		Alias: "_entities",
+		Name:  "_entities",
+		Arguments: ast.ArgumentList{{
+			Name:  "representations",
+			Value: &ast.Value{Kind: ast.Variable, Raw: "representations"},
+		}},
+		SelectionSet: ast.SelectionSet{&ast.InlineFragment{
+			TypeCondition: f.representation,
+			SelectionSet:  f.selections,
+		}},
+	}
+	representationsVar := &ast.VariableDefinition{
+		Variable: "representations",
+		Type:     ast.NonNullListType(ast.NonNullNamedType("_Any", nil), nil),
+	}
+	query, vars := e.operation(ast.Query, ast.VariableDefinitionList{representationsVar}, ast.SelectionSet{entities})
+	vars["representations"] = representations
+
+	resp := e.do(ctx, f.subgraph, query, vars)
+	if resp == nil {
+		return
+	}
+
+	for _, err := range resp.Errors {
+		// point the errors at the entities in the response of the gateway
+		if len(err.Path) >= 2 && err.Path[0] == ast.PathName("_entities") {
+			if i, ok := err.Path[1].(ast.PathIndex); ok && int(i) < len(paths) {
+				err.Path = append(append(ast.Path{}, paths[i]...), err.Path[2:]...)
+			}
+		}
+		e.errors = append(e.errors, err)
+	}
+
+	results, _ := resp.Data["_entities"].([]interface{})
+	for i, result := range results {
+		if i < len(objects) {
+			merge(objects[i], result)
+		}
+	}
+}
+
+// plan returns the selections of a selection set that a subgraph can resolve for an object of the parent type.
+// A fetch is queued for the fields that other subgraphs resolve, and the fields they need from the entity are added
+// to the selections.
+func (e *execution) plan(sg *subgraph, parent *ast.Definition, set ast.SelectionSet, path []string) ast.SelectionSet {
+	selections := ast.SelectionSet{&ast.Field{Alias: "__typename", Name: "__typename"}}
+	fetches := map[*subgraph]*fetch{}
+	var order []*fetch
+
+	for _, sel := range set {
+		switch sel := sel.(type) {
+		case *ast.Field:
+			if strings.HasPrefix(sel.Name, "__") {
+				continue
+			}
+
+			if e.gateway.resolves(sg, parent.Name, sel.Name) {
+				selections = append(selections, e.planField(sg, sel, path))
+				continue
+			}
+
+			if parent.IsAbstractType() {
+				// the implementations may be resolved by other subgraphs
+				for _, impl := range e.gateway.schema.GetPossibleTypes(parent) {
+					if _, ok := sg.types[impl.Name]; !ok {
+						continue
+					}
+					selections = append(selections, &ast.InlineFragment{
+						TypeCondition: impl.Name,
+						SelectionSet:  e.plan(sg, impl, ast.SelectionSet{sel}, path),
+					})
+				}
+				continue
+			}
+
+			owner, representation := e.gateway.owner(parent, sel.Name)
+			if owner == nil || owner == sg {
+				e.errors = append(e.errors, gqlerror.Errorf("no subgraph resolves %s.%s", parent.Name, sel.Name))
+				continue
+			}
+
+			f := fetches[owner]
+			if f == nil {
+				f = &fetch{
+					subgraph:       owner,
+					path:           path,
+					typeName:       parent.Name,
+					representation: representation,
+					fields:         []string{e.gateway.key(sg, owner, parent.Name, representation)},
+				}
+				fetches[owner] = f
+				order = append(order, f)
+			}
+			if requires, ok := owner.requires[representation][sel.Name]; ok {
+				f.fields = append(f.fields, requires)
+			}
+			f.selections = append(f.selections, sel)
+
+		case *ast.InlineFragment:
+			typeCondition := sel.TypeCondition
+			if typeCondition == "" {
+				typeCondition = parent.Name
+			}
+			selections = append(selections, e.planFragment(sg, typeCondition, sel.Directives, sel.SelectionSet, path)...)
+
+		case *ast.FragmentSpread:
+			fragment := e.rc.Doc.Fragments.ForName(sel.Name)
+			selections = append(selections, e.planFragment(sg, fragment.TypeCondition, sel.Directives, fragment.SelectionSet, path)...)
+		}
+	}
+
+	for _, f := range order {
+		for _, fields := range f.fields {
+			selections = append(selections, parseFieldSet(fields)...)
+		}
+		// the fetch is queued before the ones planned for its own selections, which need its results
+		e.fetches = append(e.fetches, f)
+		f.selections = e.plan(f.subgraph, e.gateway.schema.Types[f.representation], f.selections, path)
+	}
+
+	return selections
+}
+
+func (e *execution) planFragment(sg *subgraph, typeCondition string, directives ast.DirectiveList, set ast.SelectionSet, path []string) ast.SelectionSet {
+	if _, ok := sg.types[typeCondition]; !ok {
+		// the subgraph can't return objects of a type it doesn't know
+		return nil
+	}
+	return ast.SelectionSet{&ast.InlineFragment{
+		TypeCondition: typeCondition,
+		Directives:    forwardedDirectives(directives),
+		SelectionSet:  e.plan(sg, e.gateway.schema.Types[typeCondition], set, path),
+	}}
+}
+
+func (e *execution) planField(sg *subgraph, sel *ast.Field, path []string) *ast.Field {
+	field := &ast.Field{
+		Alias:      sel.Alias,
+		Name:       sel.Name,
+		Arguments:  sel.Arguments,
+		Directives: forwardedDirectives(sel.Directives),
+	}
+	if len(sel.SelectionSet) > 0 {
+		child := e.gateway.schema.Types[sel.Definition.Type.Name()]
+		field.SelectionSet = e.plan(sg, child, sel.SelectionSet, append(path[:len(path):len(path)], sel.Alias))
+	}
+	return field
+}
+
+// owner returns a subgraph resolving a field of an entity, along with the __typename of its representations
+func (g *Gateway) owner(parent *ast.Definition, fieldName string) (*subgraph, string) {
+	for _, sg := range g.owners[parent.Name][fieldName] {
+		if len(sg.keys[parent.Name]) > 0 {
+			return sg, parent.Name
+		}
+	}
+	for _, iface := range parent.Interfaces {
+		for _, sg := range g.owners[iface][fieldName] {
+			if sg.interfaceObjects[iface] && len(sg.keys[iface]) > 0 {
+				return sg, iface
+			}
+		}
+	}
+	return nil, ""
+}
+
+// key returns the key of the owner used to fetch entities of a type from it, preferring the keys that the subgraph
+// returning the entities also has.
+func (g *Gateway) key(sg, owner *subgraph, typeName, representation string) string {
+	for _, key := range owner.keys[representation] {
+		for _, known := range sg.allKeys[typeName] {
+			if normalizeFieldSet(key) == normalizeFieldSet(known) {
+				return key
+			}
+		}
+	}
+	return owner.keys[representation][0]
+}
+
+// operation formats an operation, declaring the variables of the operation of the gateway it uses. Their values
+// are returned along with the operation.
+func (e *execution) operation(operation ast.Operation, vars ast.VariableDefinitionList, selections ast.SelectionSet) (string, map[string]interface{}) {
+	used := map[string]bool{}
+	usedVariables(selections, used)
+
+	values := map[string]interface{}{}
+	for _, v := range e.rc.Operation.VariableDefinitions {
+		if used[v.Variable] {
+			vars = append(vars, &ast.VariableDefinition{Variable: v.Variable, Type: v.Type})
+			values[v.Variable] = e.rc.Variables[v.Variable]
+		}
+	}
+
+	var buf bytes.Buffer
+	formatter.NewFormatter(&buf).FormatQueryDocument(&ast.QueryDocument{
+		Operations: ast.OperationList{{
+			Operation:           operation,
+			VariableDefinitions: vars,
+			SelectionSet:        selections,
+		}},
+	})
+	return buf.String(), values
+}
+
+// do sends an operation to a subgraph. Errors are added to the response and nil is returned when the subgraph can't
+// be reached.
+func (e *execution) do(ctx context.Context, sg *subgraph, query string, vars map[string]interface{}) *response {
+	resp, err := sg.do(ctx, query, vars)
+	if err != nil {
+		e.errors = append(e.errors, gqlerror.Errorf("%s", err.Error()))
+		return nil
+	}
+	return resp
+}
+
+// completeObject shapes the fields of an object returned by the subgraphs as requested by the operation. It returns
+// nil when a non null field is null.
+func (e *execution) completeObject(def *ast.Definition, set ast.SelectionSet, obj map[string]interface{}) *orderedMap {
+	typeName, _ := obj["__typename"].(string)
+	if runtime, ok := e.gateway.schema.Types[typeName]; ok && !runtime.IsAbstractType() {
+		def = runtime
+	}
+
+	satisfies := []string{def.Name}
+	for _, impl := range e.gateway.schema.GetImplements(def) {
+		satisfies = append(satisfies, impl.Name)
+	}
+
+	result := &orderedMap{values: map[string]interface{}{}}
+	for _, field := range graphql.CollectFields(e.rc, set, satisfies) {
+		var value interface{}
+		if field.Name == "__typename" {
+			value = def.Name
+		} else if fieldDef := def.Fields.ForName(field.Name); fieldDef != nil {
+			value = e.completeValue(fieldDef.Type, field.Selections, obj[field.Alias])
+			if value == nil && fieldDef.Type.NonNull {
+				return nil
+			}
+		}
+		result.keys = append(result.keys, field.Alias)
+		result.values[field.Alias] = value
+	}
+	return result
+}
+
+func (e *execution) completeValue(typ *ast.Type, set ast.SelectionSet, value interface{}) interface{} {
+	if value == nil {
+		return nil
+	}
+
+	if typ.Elem != nil {
+		list, ok := value.([]interface{})
+		if !ok {
+			return nil
+		}
+		result := make([]interface{}, len(list))
+		for i, item := range list {
+			result[i] = e.completeValue(typ.Elem, set, item)
+			if result[i] == nil && typ.Elem.NonNull {
+				return nil
+			}
+		}
+		return result
+	}
+
+	def := e.gateway.schema.Types[typ.Name()]
+	if def.IsLeafType() {
+		return value
+	}
+
+	obj, ok := value.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	if result := e.completeObject(def, set, obj); result != nil {
+		return result
+	}
+	return nil
+}
+
+// collectEntities finds the objects of a type at a path of the response, along with their full path
+func collectEntities(value interface{}, keys []string, path ast.Path, typeName string, objects *[]map[string]interface{}, paths *[]ast.Path) {
+	switch value := value.(type) {
+	case []interface{}:
+		for i, item := range value {
+			collectEntities(item, keys, append(path[:len(path):len(path)], ast.PathIndex(i)), typeName, objects, paths)
+		}
+	case map[string]interface{}:
+		if len(keys) > 0 {
+			collectEntities(value[keys[0]], keys[1:], append(path[:len(path):len(path)], ast.PathName(keys[0])), typeName, objects, paths)
+			return
+		}
+		if value["__typename"] == typeName {
+			*objects = append(*objects, value)
+			*paths = append(*paths, path)
+		}
+	}
+}
+
+// copyFieldSet copies the fields of a field set from an entity into its representation
+func copyFieldSet(dst, src map[string]interface{}, set ast.SelectionSet) {
+	for _, sel := range set {
+		field, ok := sel.(*ast.Field)
+		if !ok {
+			continue
+		}
+		value, ok := src[field.Name]
+		if !ok {
+			continue
+		}
+		if len(field.SelectionSet) == 0 {
+			dst[field.Name] = value
+			continue
+		}
+		child, ok := value.(map[string]interface{})
+		if !ok {
+			dst[field.Name] = value
+			continue
+		}
+		nested, _ := dst[field.Name].(map[string]interface{})
+		if nested == nil {
+			nested = map[string]interface{}{}
+			dst[field.Name] = nested
+		}
+		copyFieldSet(nested, child, field.SelectionSet)
+	}
+}
+
+// merge adds the fields of an entity returned by a subgraph to the entity found in the response
+func merge(dst map[string]interface{}, src interface{}) {
+	fields, ok := src.(map[string]interface{})
+	if !ok {
+		return
+	}
+	for k, v := range fields {
+		existing, ok := dst[k]
+		if !ok {
+			dst[k] = v
+			continue
+		}
+		if k == "__typename" {
+			// @interfaceObject subgraphs return the name of the interface
+			continue
+		}
+		switch existing := existing.(type) {
+		case map[string]interface{}:
+			merge(existing, v)
+		case []interface{}:
+			list, ok := v.([]interface{})
+			if !ok || len(list) != len(existing) {
+				dst[k] = v
+				continue
+			}
+			for i, item := range existing {
+				if obj, ok := item.(map[string]interface{}); ok {
+					merge(obj, list[i])
+				} else {
+					existing[i] = list[i]
+				}
+			}
+		default:
+			dst[k] = v
+		}
+	}
+}
+
+func usedVariables(set ast.SelectionSet, used map[string]bool) {
+	for _, sel := range set {
+		switch sel := sel.(type) {
+		case *ast.Field:
+			for _, arg := range sel.Arguments {
+				valueVariables(arg.Value, used)
+			}
+			for _, dir := range sel.Directives {
+				for _, arg := range dir.Arguments {
+					valueVariables(arg.Value, used)
+				}
+			}
+			usedVariables(sel.SelectionSet, used)
+		case *ast.InlineFragment:
+			for _, dir := range sel.Directives {
+				for _, arg := range dir.Arguments {
+					valueVariables(arg.Value, used)
+				}
+			}
+			usedVariables(sel.SelectionSet, used)
+		}
+	}
+}
+
+func valueVariables(value *ast.Value, used map[string]bool) {
+	if value == nil {
+		return
+	}
+	if value.Kind == ast.Variable {
+		used[value.Raw] = true
+	}
+	for _, child := range value.Children {
+		valueVariables(child.Value, used)
+	}
+}
+
+// forwardedDirectives keeps the directives the subgraphs need to see, incremental delivery is not supported by the
+// gateway
+func forwardedDirectives(list ast.DirectiveList) ast.DirectiveList {
+	var directives ast.DirectiveList
+	for _, dir := range list {
+		if dir.Name == "skip" || dir.Name == "include" {
+			directives = append(directives, dir)
+		}
+	}
+	return directives
+}
+
+// normalizeFieldSet returns a field set in a canonical form, to compare the keys of subgraphs
+func normalizeFieldSet(raw string) string {
+	var fields []string
+	var walk func(prefix string, set ast.SelectionSet)
+	walk = func(prefix string, set ast.SelectionSet) {
+		for _, sel := range set {
+			if field, ok := sel.(*ast.Field); ok {
+				fields = append(fields, prefix+field.Name)
+				walk(prefix+field.Name+".", field.SelectionSet)
+			}
+		}
+	}
+	walk("", parseFieldSet(raw))
+	sort.Strings(fields)
+	return strings.Join(fields, " ")
+}
+
+// orderedMap is a json object keeping the order of the fields of the operation
+type orderedMap struct {
+	keys   []string
+	values map[string]interface{}
+}
+
+func (m *orderedMap) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, k := range m.keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, _ := json.Marshal(k)
+		buf.Write(key)
+		buf.WriteByte(':')
+		value, err := json.Marshal(m.values[k])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,190 @@
+// Package gateway composes services built with the federation plugin into a supergraph, and executes queries
+// against it by planning the _entities calls needed to join the subgraphs. It is meant to test federated services
+// together, without running an external gateway.
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// Subgraph is a service built with the federation plugin
+type Subgraph struct {
+	Name    string
+	Handler http.Handler
+}
+
+// Gateway executes queries against the supergraph composed from its subgraphs.
+type Gateway struct {
+	schema    *ast.Schema
+	sdl       string
+	subgraphs []*subgraph
+
+	// owners are the subgraphs that resolve each field, by type name and field name
+	owners map[string]map[string][]*subgraph
+}
+
+type subgraph struct {
+	name    string
+	handler http.Handler
+	sdl     string
+
+	// types are the types the subgraph knows of, by name
+	types map[string]*ast.Definition
+
+	// keys are the field sets of the resolvable @key directives, by type name
+	keys map[string][]string
+
+	// allKeys also contains the keys that are not resolvable
+	allKeys map[string][]string
+
+	// requires are the field sets of the @requires directives, by type name and field name
+	requires map[string]map[string]string
+
+	// interfaceObjects are the interfaces the subgraph contributes fields to with @interfaceObject
+	interfaceObjects map[string]bool
+}
+
+type response struct {
+	Data   map[string]interface{} `json:"data"`
+	Errors gqlerror.List          `json:"errors"`
+}
+
+var _ http.Handler = &Gateway{}
+
+// Compose fetches the SDL of every subgraph from its _service field, and composes them into a supergraph.
+func Compose(ctx context.Context, subgraphs ...Subgraph) (*Gateway, error) {
+	if len(subgraphs) == 0 {
+		return nil, fmt.Errorf("at least one subgraph is needed")
+	}
+
+	g := &Gateway{}
+	for _, s := range subgraphs {
+		if s.Name == "" || s.Handler == nil {
+			return nil, fmt.Errorf("subgraphs need a name and a handler")
+		}
+		sg := &subgraph{name: s.Name, handler: s.Handler}
+
+		resp, err := sg.do(ctx, "{ _service { sdl } }", nil)
+		if err != nil {
+			return nil, err
+		}
+		if len(resp.Errors) > 0 {
+			return nil, fmt.Errorf("fetching the sdl of %s: %w", sg.name, resp.Errors)
+		}
+		service, _ := resp.Data["_service"].(map[string]interface{})
+		sdl, ok := service["sdl"].(string)
+		if !ok {
+			return nil, fmt.Errorf("fetching the sdl of %s: _service.sdl is not a string", sg.name)
+		}
+		sg.sdl = sdl
+
+		g.subgraphs = append(g.subgraphs, sg)
+	}
+
+	if err := g.compose(); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// Schema returns the schema of the supergraph, as seen by clients.
+func (g *Gateway) Schema() *ast.Schema {
+	return g.schema
+}
+
+// SDL returns the schema of the supergraph in the schema definition language.
+func (g *Gateway) SDL() string {
+	return g.sdl
+}
+
+// ServeHTTP executes the operations POSTed as json.
+func (g *Gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		writeJSON(w, &graphql.Response{Errors: gqlerror.List{gqlerror.Errorf("the gateway only supports POST requests")}})
+		return
+	}
+
+	var params graphql.RawParams
+	dec := json.NewDecoder(r.Body)
+	dec.UseNumber()
+	if err := dec.Decode(&params); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		writeJSON(w, &graphql.Response{Errors: gqlerror.List{gqlerror.Errorf("json body could not be decoded: %s", err.Error())}})
+		return
+	}
+
+	writeJSON(w, g.Execute(r.Context(), &params))
+}
+
+// Remote returns a handler forwarding requests to the subgraph served at url, to compose services that are not
+// running in the same process.
+func Remote(url string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req, err := http.NewRequestWithContext(r.Context(), r.Method, url, r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+		req.Header = r.Header.Clone()
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+		defer resp.Body.Close()
+
+		for k, v := range resp.Header {
+			w.Header()[k] = v
+		}
+		w.WriteHeader(resp.StatusCode)
+		_, _ = io.Copy(w, resp.Body)
+	})
+}
+
+// do sends an operation to the subgraph
+func (sg *subgraph) do(ctx context.Context, query string, variables map[string]interface{}) (*response, error) {
+	body, err := json.Marshal(map[string]interface{}{"query": query, "variables": variables})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	sg.handler.ServeHTTP(w, req)
+
+	b := w.Body.Bytes()
+	var resp response
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	if err := dec.Decode(&resp); err != nil {
+		return nil, fmt.Errorf("subgraph %s responded with status %d and an invalid body: %s", sg.name, w.Code, b)
+	}
+	return &resp, nil
+}
+
+func writeJSON(w io.Writer, resp *graphql.Response) {
+	b, err := json.Marshal(resp)
+	if err != nil {
+		panic(err)
+	}
+	_, _ = w.Write(b)
+}
@@ -0,0 +1,131 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/99designs/gqlgen/client"
+)
+
+// stub is a subgraph serving its sdl and a canned response to every other operation
+type stub struct {
+	sdl       string
+	response  string
+	operation string
+	variables map[string]interface{}
+}
+
+func (s *stub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var params struct {
+		Query     string                 `json:"query"`
+		Variables map[string]interface{} `json:"variables"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+		panic(err)
+	}
+
+	if strings.Contains(params.Query, "_service") {
+		b, _ := json.Marshal(map[string]interface{}{"data": map[string]interface{}{"_service": map[string]interface{}{"sdl": s.sdl}}})
+		_, _ = w.Write(b)
+		return
+	}
+
+	s.operation = params.Query
+	s.variables = params.Variables
+	_, _ = w.Write([]byte(s.response))
+}
+
+func TestGateway(t *testing.T) {
+	users := &stub{
+		sdl: `
+			type Query { me: User }
+			type User @key(fields: "id") {
+				id: ID!
+				name: String!
+				email: String! @inaccessible
+			}
+		`,
+		response: `{"data": {"me": {"__typename": "User", "id": "1", "name": "Ada"}}}`,
+	}
+	posts := &stub{
+		sdl: `
+			type User @key(fields: "id") {
+				id: ID!
+				posts(first: Int): [Post!]!
+			}
+			type Post { title: String! }
+		`,
+		response: `{"data": {"_entities": [{"__typename": "User", "posts": [{"__typename": "Post", "title": "Hello"}]}]}}`,
+	}
+
+	gw, err := Compose(context.Background(), Subgraph{Name: "users", Handler: users}, Subgraph{Name: "posts", Handler: posts})
+	require.NoError(t, err)
+
+	t.Run("composes the supergraph", func(t *testing.T) {
+		require.NotNil(t, gw.Schema().Types["Post"])
+		require.Equal(t, []string{"id", "name", "posts"}, fieldNames(gw.Schema().Types["User"]))
+		require.NotContains(t, gw.SDL(), "email")
+	})
+
+	t.Run("fetches entities from the subgraphs resolving their fields", func(t *testing.T) {
+		var resp struct {
+			Me struct {
+				Name  string
+				Posts []struct{ Title string }
+			}
+		}
+		client.New(gw).MustPost(`query($n: Int) { me { name posts(first: $n) { title } } }`, &resp, client.Var("n", 2))
+
+		require.Equal(t, "Ada", resp.Me.Name)
+		require.Equal(t, "Hello", resp.Me.Posts[0].Title)
+
+		require.Contains(t, users.operation, "id")
+		require.NotContains(t, users.operation, "posts")
+		require.Contains(t, posts.operation, "_entities(representations: $representations)")
+		require.Contains(t, posts.operation, "... on User")
+		require.Equal(t, []interface{}{map[string]interface{}{"__typename": "User", "id": "1"}}, posts.variables["representations"])
+		require.Equal(t, float64(2), posts.variables["n"])
+	})
+
+	t.Run("points entity errors at the response", func(t *testing.T) {
+		posts.response = `{"data": {"_entities": [null]}, "errors": [{"message": "boom", "path": ["_entities", 0, "posts"]}]}`
+		resp, err := client.New(gw).RawPost(`{ me { name posts { title } } }`)
+		require.NoError(t, err)
+		require.JSONEq(t, `[{"message": "boom", "path": ["me", "posts"]}]`, string(resp.Errors))
+		// posts is not nullable, so me is null
+		require.Equal(t, map[string]interface{}{"me": nil}, resp.Data)
+	})
+}
+
+func TestCompose(t *testing.T) {
+	compose := func(sdls ...string) error {
+		var subgraphs []Subgraph
+		for i, sdl := range sdls {
+			subgraphs = append(subgraphs, Subgraph{Name: string(rune('a' + i)), Handler: &stub{sdl: sdl}})
+		}
+		_, err := Compose(context.Background(), subgraphs...)
+		return err
+	}
+
+	require.NoError(t, compose(`type Query { a: String }`, `extend type Query { b: Int }`))
+	require.EqualError(t, compose(`type Query { a: String }`, `type Query { a: Int }`),
+		"Query.a has type Int in b and String in another subgraph")
+	require.EqualError(t, compose(`type Query { a: A } type A { b: Int }`, `input A { b: Int }`),
+		"A is an input object in b and an object in another subgraph")
+	require.EqualError(t, compose(`type Query { a: A }`),
+		"composing the supergraph: supergraph.graphql:2: Undefined type A.")
+}
+
+func fieldNames(def *ast.Definition) []string {
+	var names []string
+	for _, field := range def.Fields {
+		names = append(names, field.Name)
+	}
+	return names
+}
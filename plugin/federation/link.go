@@ -0,0 +1,170 @@
+package federation
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
+
+	"github.com/99designs/gqlgen/codegen/config"
+)
+
+const federationSpecURL = "https://specs.apollo.dev/federation/v2.3"
+
+var federationSpecRe = regexp.MustCompile(`^https://specs\.apollo\.dev/federation/v2\.\d+$`)
+
+// federationImports are the names of the federation 2 specification
+// that a schema can import with @link
+var federationImports = []string{
+	"@composeDirective",
+	"@extends",
+	"@external",
+	"@inaccessible",
+	"@interfaceObject",
+	"@key",
+	"@override",
+	"@provides",
+	"@requires",
+	"@shareable",
+	"@tag",
+	"FieldSet",
+}
+
+// setLink checks the @link to the federation specification of the schema.
+// When there is none, every federation directive is imported by a @link
+// added to the SDL served by _service.
+func (f *federation) setLink(cfg *config.Config) error {
+	doc, err := parser.ParseSchemas(cfg.Sources...)
+	if err != nil {
+		return err
+	}
+
+	var link *ast.Directive
+	var composed []*ast.Directive
+	for _, def := range append(doc.Schema, doc.SchemaExtension...) {
+		for _, dir := range def.Directives {
+			switch dir.Name {
+			case "link":
+				url := dir.Arguments.ForName("url")
+				if url == nil || !strings.HasPrefix(url.Value.Raw, "https://specs.apollo.dev/federation/") {
+					continue
+				}
+				if !federationSpecRe.MatchString(url.Value.Raw) {
+					return fmt.Errorf("federation version 2 needs a @link to a v2 federation specification, got %s", url.Value.Raw)
+				}
+				if link != nil {
+					return fmt.Errorf("the federation specification can only be linked once")
+				}
+				link = dir
+			case "composeDirective":
+				composed = append(composed, dir)
+			}
+		}
+	}
+
+	for _, dir := range composed {
+		name := dir.Arguments.ForName("name")
+		if name == nil || !strings.HasPrefix(name.Value.Raw, "@") || cfg.Schema.Directives[strings.TrimPrefix(name.Value.Raw, "@")] == nil {
+			return fmt.Errorf("@composeDirective must name a directive defined in the schema, like \"@custom\"")
+		}
+	}
+
+	if link == nil {
+		imports := make([]string, len(federationImports))
+		for i, name := range federationImports {
+			imports[i] = fmt.Sprintf("%q", name)
+		}
+		f.Link = fmt.Sprintf("extend schema @link(url: %q, import: [%s])", federationSpecURL, strings.Join(imports, ", "))
+		return nil
+	}
+
+	imported := map[string]bool{}
+	if arg := link.Arguments.ForName("import"); arg != nil {
+		for _, value := range arg.Value.Children {
+			name, err := importName(value.Value)
+			if err != nil {
+				return err
+			}
+			if !isFederationImport(name) {
+				return fmt.Errorf("%s is not part of the federation specification", name)
+			}
+			imported[name] = true
+		}
+	}
+
+	// the directives that are not imported would have to be used
+	// with a federation__ prefix, which is not supported
+	used := map[string]bool{}
+	walkDirectives(cfg.Schema, func(dir *ast.Directive) {
+		if isFederationImport("@"+dir.Name) && !imported["@"+dir.Name] {
+			used["@"+dir.Name] = true
+		}
+	})
+	if len(used) > 0 {
+		missing := make([]string, 0, len(used))
+		for name := range used {
+			missing = append(missing, name)
+		}
+		sort.Strings(missing)
+		return fmt.Errorf("%s must be imported by the @link to the federation specification", strings.Join(missing, ", "))
+	}
+
+	return nil
+}
+
+// importName returns the name of an element of the import argument
+// of @link, which is either a string or a {name: "", as: ""} object
+func importName(value *ast.Value) (string, error) {
+	switch value.Kind {
+	case ast.StringValue:
+		return value.Raw, nil
+	case ast.ObjectValue:
+		name := value.Children.ForName("name")
+		if name == nil {
+			return "", fmt.Errorf("@link imports need a name")
+		}
+		if as := value.Children.ForName("as"); as != nil && as.Raw != name.Raw {
+			return "", fmt.Errorf("renaming %s to %s in @link imports is not supported", name.Raw, as.Raw)
+		}
+		return name.Raw, nil
+	default:
+		return "", fmt.Errorf("@link imports must be strings or objects, got %s", value.String())
+	}
+}
+
+func isFederationImport(name string) bool {
+	for _, n := range federationImports {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// walkDirectives calls fn for every directive used by the types of the schema
+func walkDirectives(schema *ast.Schema, fn func(dir *ast.Directive)) {
+	each := func(list ast.DirectiveList) {
+		for _, dir := range list {
+			fn(dir)
+		}
+	}
+
+	for _, def := range schema.Types {
+		if def.BuiltIn {
+			continue
+		}
+		each(def.Directives)
+		for _, field := range def.Fields {
+			each(field.Directives)
+			for _, arg := range field.Arguments {
+				each(arg.Directives)
+			}
+		}
+		for _, value := range def.EnumValues {
+			each(value.Directives)
+		}
+	}
+}
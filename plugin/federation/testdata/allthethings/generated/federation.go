@@ -10,6 +10,7 @@ import (
 	"sync"
 
 	"github.com/99designs/gqlgen/plugin/federation/fedruntime"
+	"github.com/99designs/gqlgen/plugin/federation/testdata/allthethings/model"
 )
 
 var (
@@ -177,13 +178,27 @@ func (ec *executionContext) __resolve_entities(ctx context.Context, representati
 					return fmt.Errorf(`resolving Entity "VeryNestedKey": %w`, err)
 				}
 
-				entity.ID, err = ec.unmarshalNString2string(ctx, rep["id"])
-				if err != nil {
-					return err
+				index := idx[i]
+				{
+					value, err := fedruntime.RequiredField(rep, "id")
+					if err == nil {
+						entity.ID, err = ec.unmarshalNString2string(ctx, value)
+					}
+					if err != nil {
+						return fedruntime.RequiresError(ctx, index, "id", err)
+					}
 				}
-				entity.Hello.Secondary, err = ec.unmarshalNString2string(ctx, rep["hello"].(map[string]interface{})["secondary"])
-				if err != nil {
-					return err
+				{
+					value, err := fedruntime.RequiredField(rep, "hello", "secondary")
+					if err == nil {
+						if entity.Hello == nil {
+							entity.Hello = new(model.Hello)
+						}
+						entity.Hello.Secondary, err = ec.unmarshalNString2string(ctx, value)
+					}
+					if err != nil {
+						return fedruntime.RequiresError(ctx, index, "hello.secondary", err)
+					}
 				}
 				list[idx[i]] = entity
 				return nil
@@ -6,10 +6,17 @@ package entityresolver
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/99designs/gqlgen/plugin/federation/testdata/entityresolver/generated"
 )
 
+func (r *entityResolver) FindBookByID(ctx context.Context, id string) (*generated.Book, error) {
+	return &generated.Book{
+		ID: id,
+	}, nil
+}
+
 func (r *entityResolver) FindHelloByName(ctx context.Context, name string) (*generated.Hello, error) {
 	return &generated.Hello{
 		Name: name,
@@ -32,6 +39,28 @@ func (r *entityResolver) FindHelloWithErrorsByName(ctx context.Context, name str
 	}, nil
 }
 
+func (r *entityResolver) FindMediaByID(ctx context.Context, id string) (generated.Media, error) {
+	switch {
+	case strings.HasPrefix(id, "book-"):
+		return &generated.Book{
+			ID:    id,
+			Title: "Book " + id,
+		}, nil
+	case strings.HasPrefix(id, "movie-"):
+		return &generated.Movie{
+			ID:    id,
+			Title: "Movie " + id,
+		}, nil
+	}
+	return nil, fmt.Errorf("unknown media: %s", id)
+}
+
+func (r *entityResolver) FindMovieByID(ctx context.Context, id string) (*generated.Movie, error) {
+	return &generated.Movie{
+		ID: id,
+	}, nil
+}
+
 func (r *entityResolver) FindManyMultiHelloByNames(ctx context.Context, reps []*generated.MultiHelloByNamesInput) ([]*generated.MultiHello, error) {
 	results := []*generated.MultiHello{}
 
@@ -48,6 +77,18 @@ func (r *entityResolver) FindManyMultiHelloWithErrorByNames(ctx context.Context,
 	return nil, fmt.Errorf("error resolving MultiHelloWorldWithError")
 }
 
+func (r *entityResolver) FindManyMultiPlanetRequiresNestedByNames(ctx context.Context, reps []*generated.MultiPlanetRequiresNestedByNamesInput) ([]*generated.MultiPlanetRequiresNested, error) {
+	results := make([]*generated.MultiPlanetRequiresNested, len(reps))
+
+	for i, rep := range reps {
+		results[i] = &generated.MultiPlanetRequiresNested{
+			Name: rep.Name,
+		}
+	}
+
+	return results, nil
+}
+
 func (r *entityResolver) FindPlanetRequiresByName(ctx context.Context, name string) (*generated.PlanetRequires, error) {
 	return &generated.PlanetRequires{
 		Name: name,
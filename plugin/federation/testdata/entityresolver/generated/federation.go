@@ -69,6 +69,8 @@ func (ec *executionContext) __resolve_entities(ctx context.Context, representati
 			return true
 		case "MultiHelloWithError":
 			return true
+		case "MultiPlanetRequiresNested":
+			return true
 		default:
 			return false
 		}
@@ -84,6 +86,26 @@ func (ec *executionContext) __resolve_entities(ctx context.Context, representati
 		}()
 
 		switch typeName {
+		case "Book":
+			resolverName, err := entityResolverNameForBook(ctx, rep)
+			if err != nil {
+				return fmt.Errorf(`finding resolver for Entity "Book": %w`, err)
+			}
+			switch resolverName {
+
+			case "findBookByID":
+				id0, err := ec.unmarshalNID2string(ctx, rep["id"])
+				if err != nil {
+					return fmt.Errorf(`unmarshalling param 0 for findBookByID(): %w`, err)
+				}
+				entity, err := ec.resolvers.Entity().FindBookByID(ctx, id0)
+				if err != nil {
+					return fmt.Errorf(`resolving Entity "Book": %w`, err)
+				}
+
+				list[idx[i]] = entity
+				return nil
+			}
 		case "Hello":
 			resolverName, err := entityResolverNameForHello(ctx, rep)
 			if err != nil {
@@ -145,6 +167,50 @@ func (ec *executionContext) __resolve_entities(ctx context.Context, representati
 					return fmt.Errorf(`resolving Entity "HelloWithErrors": %w`, err)
 				}
 
+				list[idx[i]] = entity
+				return nil
+			}
+		case "Media":
+			resolverName, err := entityResolverNameForMedia(ctx, rep)
+			if err != nil {
+				return fmt.Errorf(`finding resolver for Entity "Media": %w`, err)
+			}
+			switch resolverName {
+
+			case "findMediaByID":
+				id0, err := ec.unmarshalNID2string(ctx, rep["id"])
+				if err != nil {
+					return fmt.Errorf(`unmarshalling param 0 for findMediaByID(): %w`, err)
+				}
+				entity, err := ec.resolvers.Entity().FindMediaByID(ctx, id0)
+				if err != nil {
+					return fmt.Errorf(`resolving Entity "Media": %w`, err)
+				}
+
+				e, ok := entity.(fedruntime.Entity)
+				if !ok {
+					return fmt.Errorf(`resolving Entity "Media": %T is not an entity`, entity)
+				}
+				list[idx[i]] = e
+				return nil
+			}
+		case "Movie":
+			resolverName, err := entityResolverNameForMovie(ctx, rep)
+			if err != nil {
+				return fmt.Errorf(`finding resolver for Entity "Movie": %w`, err)
+			}
+			switch resolverName {
+
+			case "findMovieByID":
+				id0, err := ec.unmarshalNID2string(ctx, rep["id"])
+				if err != nil {
+					return fmt.Errorf(`unmarshalling param 0 for findMovieByID(): %w`, err)
+				}
+				entity, err := ec.resolvers.Entity().FindMovieByID(ctx, id0)
+				if err != nil {
+					return fmt.Errorf(`resolving Entity "Movie": %w`, err)
+				}
+
 				list[idx[i]] = entity
 				return nil
 			}
@@ -165,9 +231,15 @@ func (ec *executionContext) __resolve_entities(ctx context.Context, representati
 					return fmt.Errorf(`resolving Entity "PlanetRequires": %w`, err)
 				}
 
-				entity.Diameter, err = ec.unmarshalNInt2int(ctx, rep["diameter"])
-				if err != nil {
-					return err
+				index := idx[i]
+				{
+					value, err := fedruntime.RequiredField(rep, "diameter")
+					if err == nil {
+						entity.Diameter, err = ec.unmarshalNInt2int(ctx, value)
+					}
+					if err != nil {
+						return fedruntime.RequiresError(ctx, index, "diameter", err)
+					}
 				}
 				list[idx[i]] = entity
 				return nil
@@ -189,9 +261,18 @@ func (ec *executionContext) __resolve_entities(ctx context.Context, representati
 					return fmt.Errorf(`resolving Entity "PlanetRequiresNested": %w`, err)
 				}
 
-				entity.World.Foo, err = ec.unmarshalNString2string(ctx, rep["world"].(map[string]interface{})["foo"])
-				if err != nil {
-					return err
+				index := idx[i]
+				{
+					value, err := fedruntime.RequiredField(rep, "world", "foo")
+					if err == nil {
+						if entity.World == nil {
+							entity.World = new(World)
+						}
+						entity.World.Foo, err = ec.unmarshalNString2string(ctx, value)
+					}
+					if err != nil {
+						return fedruntime.RequiresError(ctx, index, "world.foo", err)
+					}
 				}
 				list[idx[i]] = entity
 				return nil
@@ -340,6 +421,43 @@ func (ec *executionContext) __resolve_entities(ctx context.Context, representati
 			}
 			return nil
 
+		case "MultiPlanetRequiresNested":
+			_reps := make([]*MultiPlanetRequiresNestedByNamesInput, len(reps))
+
+			for i, rep := range reps {
+				id0, err := ec.unmarshalNString2string(ctx, rep["name"])
+				if err != nil {
+					return errors.New(fmt.Sprintf("Field %s undefined in schema.", "name"))
+				}
+
+				_reps[i] = &MultiPlanetRequiresNestedByNamesInput{
+					Name: id0,
+				}
+			}
+
+			entities, err := ec.resolvers.Entity().FindManyMultiPlanetRequiresNestedByNames(ctx, _reps)
+			if err != nil {
+				return err
+			}
+
+			for i, entity := range entities {
+				rep, index := reps[i], idx[i]
+				{
+					value, err := fedruntime.RequiredField(rep, "world", "foo")
+					if err == nil {
+						if entity.World == nil {
+							entity.World = new(World)
+						}
+						entity.World.Foo, err = ec.unmarshalNString2string(ctx, value)
+					}
+					if err != nil {
+						return fedruntime.RequiresError(ctx, index, "world.foo", err)
+					}
+				}
+				list[idx[i]] = entity
+			}
+			return nil
+
 		default:
 			return errors.New("unknown type: " + typeName)
 		}
@@ -393,6 +511,23 @@ func (ec *executionContext) __resolve_entities(ctx context.Context, representati
 	}
 }
 
+func entityResolverNameForBook(ctx context.Context, rep map[string]interface{}) (string, error) {
+	for {
+		var (
+			m   map[string]interface{}
+			val interface{}
+			ok  bool
+		)
+		_ = val
+		m = rep
+		if _, ok = m["id"]; !ok {
+			break
+		}
+		return "findBookByID", nil
+	}
+	return "", fmt.Errorf("%w for Book", ErrTypeNotFound)
+}
+
 func entityResolverNameForHello(ctx context.Context, rep map[string]interface{}) (string, error) {
 	for {
 		var (
@@ -448,6 +583,40 @@ func entityResolverNameForHelloWithErrors(ctx context.Context, rep map[string]in
 	return "", fmt.Errorf("%w for HelloWithErrors", ErrTypeNotFound)
 }
 
+func entityResolverNameForMedia(ctx context.Context, rep map[string]interface{}) (string, error) {
+	for {
+		var (
+			m   map[string]interface{}
+			val interface{}
+			ok  bool
+		)
+		_ = val
+		m = rep
+		if _, ok = m["id"]; !ok {
+			break
+		}
+		return "findMediaByID", nil
+	}
+	return "", fmt.Errorf("%w for Media", ErrTypeNotFound)
+}
+
+func entityResolverNameForMovie(ctx context.Context, rep map[string]interface{}) (string, error) {
+	for {
+		var (
+			m   map[string]interface{}
+			val interface{}
+			ok  bool
+		)
+		_ = val
+		m = rep
+		if _, ok = m["id"]; !ok {
+			break
+		}
+		return "findMovieByID", nil
+	}
+	return "", fmt.Errorf("%w for Movie", ErrTypeNotFound)
+}
+
 func entityResolverNameForMultiHello(ctx context.Context, rep map[string]interface{}) (string, error) {
 	for {
 		var (
@@ -482,6 +651,23 @@ func entityResolverNameForMultiHelloWithError(ctx context.Context, rep map[strin
 	return "", fmt.Errorf("%w for MultiHelloWithError", ErrTypeNotFound)
 }
 
+func entityResolverNameForMultiPlanetRequiresNested(ctx context.Context, rep map[string]interface{}) (string, error) {
+	for {
+		var (
+			m   map[string]interface{}
+			val interface{}
+			ok  bool
+		)
+		_ = val
+		m = rep
+		if _, ok = m["name"]; !ok {
+			break
+		}
+		return "findManyMultiPlanetRequiresNestedByNames", nil
+	}
+	return "", fmt.Errorf("%w for MultiPlanetRequiresNested", ErrTypeNotFound)
+}
+
 func entityResolverNameForPlanetRequires(ctx context.Context, rep map[string]interface{}) (string, error) {
 	for {
 		var (
@@ -2,6 +2,19 @@
 
 package generated
 
+type Media interface {
+	IsMedia()
+}
+
+type Book struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+	Pages int    `json:"pages"`
+}
+
+func (Book) IsMedia()  {}
+func (Book) IsEntity() {}
+
 type Hello struct {
 	Name      string `json:"name"`
 	Secondary string `json:"secondary"`
@@ -22,6 +35,15 @@ type HelloWithErrors struct {
 
 func (HelloWithErrors) IsEntity() {}
 
+type Movie struct {
+	ID      string `json:"id"`
+	Title   string `json:"title"`
+	Minutes int    `json:"minutes"`
+}
+
+func (Movie) IsMedia()  {}
+func (Movie) IsEntity() {}
+
 type MultiHello struct {
 	Name string `json:"name"`
 }
@@ -42,6 +64,18 @@ type MultiHelloWithErrorByNamesInput struct {
 	Name string `json:"Name"`
 }
 
+type MultiPlanetRequiresNested struct {
+	Name  string `json:"name"`
+	World *World `json:"world"`
+	Size  int    `json:"size"`
+}
+
+func (MultiPlanetRequiresNested) IsEntity() {}
+
+type MultiPlanetRequiresNestedByNamesInput struct {
+	Name string `json:"Name"`
+}
+
 type PlanetRequires struct {
 	Name     string `json:"name"`
 	Size     int    `json:"size"`
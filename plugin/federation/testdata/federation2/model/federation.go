@@ -0,0 +1,27 @@
+package model
+
+type _FieldSet string //nolint:deadcode,unused
+
+type Product struct {
+	Upc    string
+	Sku    string
+	Name   string
+	Price  int
+	Secret *string
+}
+
+func (Product) IsEntity() {}
+
+type Review struct {
+	ID string
+}
+
+func (Review) IsEntity() {}
+
+type Inventory struct {
+	ID      string
+	Stock   int
+	Restock bool
+}
+
+func (Inventory) IsEntity() {}
@@ -0,0 +1,20 @@
+package model
+
+type _FieldSet string //nolint:deadcode,unused
+
+type Hello interface {
+	IsHello()
+}
+
+type HelloWorld struct {
+	Name      string
+	Secondary string
+	World     World
+}
+
+func (HelloWorld) IsHello()  {}
+func (HelloWorld) IsEntity() {}
+
+type World interface {
+	IsWorld()
+}